@@ -14,6 +14,9 @@ type (
 		GRPC
 		PG
 		Outbox
+		Purge
+		HoldExpiry
+		FineAssessment
 	}
 
 	GRPC struct {
@@ -32,13 +35,32 @@ type (
 	}
 
 	Outbox struct {
-		Enabled         bool          `env:"OUTBOX_ENABLED"`
-		Workers         int           `env:"OUTBOX_WORKERS"`
-		BatchSize       int           `env:"OUTBOX_BATCH_SIZE"`
-		WaitTimeMS      time.Duration `env:"OUTBOX_WAIT_TIME_MS"`
-		InProgressTTLMS time.Duration `env:"OUTBOX_IN_PROGRESS_TTL_MS"`
-		AuthorSendURL   string        `env:"OUTBOX_AUTHOR_SEND_URL"`
-		BookSendURL     string        `env:"OUTBOX_BOOK_SEND_URL"`
+		Enabled          bool          `env:"OUTBOX_ENABLED"`
+		Workers          int           `env:"OUTBOX_WORKERS"`
+		BatchSize        int           `env:"OUTBOX_BATCH_SIZE"`
+		WaitTimeMS       time.Duration `env:"OUTBOX_WAIT_TIME_MS"`
+		InProgressTTLMS  time.Duration `env:"OUTBOX_IN_PROGRESS_TTL_MS"`
+		AuthorSendURL    string        `env:"OUTBOX_AUTHOR_SEND_URL"`
+		BookSendURL      string        `env:"OUTBOX_BOOK_SEND_URL"`
+		PublisherSendURL string        `env:"OUTBOX_PUBLISHER_SEND_URL"`
+		PatronSendURL    string        `env:"OUTBOX_PATRON_SEND_URL"`
+		HoldSendURL      string        `env:"OUTBOX_HOLD_SEND_URL"`
+	}
+
+	Purge struct {
+		Enabled   bool          `env:"PURGE_ENABLED"`
+		Interval  time.Duration `env:"PURGE_INTERVAL"`
+		Retention time.Duration `env:"PURGE_RETENTION"`
+	}
+
+	HoldExpiry struct {
+		Enabled  bool          `env:"HOLD_EXPIRY_ENABLED"`
+		Interval time.Duration `env:"HOLD_EXPIRY_INTERVAL"`
+	}
+
+	FineAssessment struct {
+		Enabled  bool          `env:"FINE_ASSESSMENT_ENABLED"`
+		Interval time.Duration `env:"FINE_ASSESSMENT_INTERVAL"`
 	}
 )
 
@@ -110,6 +132,51 @@ func NewConfig() (*Config, error) {
 
 		cfg.Outbox.AuthorSendURL = os.Getenv("OUTBOX_AUTHOR_SEND_URL")
 		cfg.Outbox.BookSendURL = os.Getenv("OUTBOX_BOOK_SEND_URL")
+		cfg.Outbox.PublisherSendURL = os.Getenv("OUTBOX_PUBLISHER_SEND_URL")
+		cfg.Outbox.PatronSendURL = os.Getenv("OUTBOX_PATRON_SEND_URL")
+		cfg.Outbox.HoldSendURL = os.Getenv("OUTBOX_HOLD_SEND_URL")
+	}
+
+	cfg.Purge.Enabled, err = strconv.ParseBool(getOrDefault("PURGE_ENABLED", "false"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing PURGE_ENABLED: %w", err)
+	}
+
+	cfg.Purge.Interval, err = time.ParseDuration(getOrDefault("PURGE_INTERVAL", "1h"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing PURGE_INTERVAL: %w", err)
+	}
+
+	cfg.Purge.Retention, err = time.ParseDuration(getOrDefault("PURGE_RETENTION", "720h"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing PURGE_RETENTION: %w", err)
+	}
+
+	cfg.HoldExpiry.Enabled, err = strconv.ParseBool(getOrDefault("HOLD_EXPIRY_ENABLED", "true"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing HOLD_EXPIRY_ENABLED: %w", err)
+	}
+
+	cfg.HoldExpiry.Interval, err = time.ParseDuration(getOrDefault("HOLD_EXPIRY_INTERVAL", "5m"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing HOLD_EXPIRY_INTERVAL: %w", err)
+	}
+
+	cfg.FineAssessment.Enabled, err = strconv.ParseBool(getOrDefault("FINE_ASSESSMENT_ENABLED", "true"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing FINE_ASSESSMENT_ENABLED: %w", err)
+	}
+
+	cfg.FineAssessment.Interval, err = time.ParseDuration(getOrDefault("FINE_ASSESSMENT_INTERVAL", "24h"))
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing FINE_ASSESSMENT_INTERVAL: %w", err)
 	}
 
 	return cfg, nil
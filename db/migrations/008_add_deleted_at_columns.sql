@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE author ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE book ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX index_author_deleted_at ON author (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX index_book_deleted_at ON book (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS index_book_deleted_at;
+DROP INDEX IF EXISTS index_author_deleted_at;
+
+ALTER TABLE book DROP COLUMN deleted_at;
+ALTER TABLE author DROP COLUMN deleted_at;
\ No newline at end of file
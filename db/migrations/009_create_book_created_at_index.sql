@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX index_book_created_at ON book (created_at, id);
+
+-- +goose Down
+DROP INDEX index_book_created_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX index_author_lower_name ON author (lower(name) text_pattern_ops);
+
+-- +goose Down
+DROP INDEX index_author_lower_name;
\ No newline at end of file
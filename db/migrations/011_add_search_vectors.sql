@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION library_search_config(value TEXT) RETURNS regconfig AS
+$$
+BEGIN
+    IF value ~ '[А-Яа-яЁё]' THEN
+        RETURN 'russian'::regconfig;
+    END IF;
+
+    RETURN 'english'::regconfig;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+ALTER TABLE book ADD COLUMN search_vector tsvector;
+ALTER TABLE author ADD COLUMN search_vector tsvector;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name), NEW.name);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name), NEW.name);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_search_vector
+    BEFORE INSERT OR UPDATE OF name
+    ON book
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_search_vector();
+
+CREATE OR REPLACE TRIGGER trigger_update_author_search_vector
+    BEFORE INSERT OR UPDATE OF name
+    ON author
+    FOR EACH ROW
+EXECUTE FUNCTION update_author_search_vector();
+
+ALTER TABLE book DISABLE TRIGGER trigger_update_book_timestamp;
+UPDATE book SET search_vector = to_tsvector(library_search_config(name), name);
+ALTER TABLE book ENABLE TRIGGER trigger_update_book_timestamp;
+
+ALTER TABLE author DISABLE TRIGGER trigger_update_author_timestamp;
+UPDATE author SET search_vector = to_tsvector(library_search_config(name), name);
+ALTER TABLE author ENABLE TRIGGER trigger_update_author_timestamp;
+
+CREATE INDEX index_book_search_vector ON book USING GIN (search_vector);
+CREATE INDEX index_author_search_vector ON author USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS index_author_search_vector;
+DROP INDEX IF EXISTS index_book_search_vector;
+
+DROP TRIGGER IF EXISTS trigger_update_author_search_vector ON author;
+DROP TRIGGER IF EXISTS trigger_update_book_search_vector ON book;
+DROP FUNCTION IF EXISTS update_author_search_vector;
+DROP FUNCTION IF EXISTS update_book_search_vector;
+
+ALTER TABLE author DROP COLUMN search_vector;
+ALTER TABLE book DROP COLUMN search_vector;
+
+DROP FUNCTION IF EXISTS library_search_config;
\ No newline at end of file
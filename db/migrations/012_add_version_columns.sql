@@ -0,0 +1,33 @@
+-- +goose Up
+ALTER TABLE book ADD COLUMN version BIGINT DEFAULT 1 NOT NULL;
+ALTER TABLE author ADD COLUMN version BIGINT DEFAULT 1 NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION increment_row_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_increment_book_version
+    BEFORE UPDATE
+    ON book
+    FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+CREATE OR REPLACE TRIGGER trigger_increment_author_version
+    BEFORE UPDATE
+    ON author
+    FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_increment_author_version ON author;
+DROP TRIGGER IF EXISTS trigger_increment_book_version ON book;
+DROP FUNCTION IF EXISTS increment_row_version;
+
+ALTER TABLE author DROP COLUMN version;
+ALTER TABLE book DROP COLUMN version;
\ No newline at end of file
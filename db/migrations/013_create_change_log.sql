@@ -0,0 +1,175 @@
+-- +goose Up
+-- Kinds: 1 - book, 2 - author. Operations: 1 - created, 2 - updated, 3 - deleted, 4 - restored.
+CREATE TABLE change_log
+(
+    sequence   BIGINT PRIMARY KEY,
+    kind       INT                     NOT NULL,
+    operation  INT                     NOT NULL,
+    entity_id  UUID                    NOT NULL,
+    data       JSONB                   NOT NULL,
+    created_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+CREATE TABLE change_log_sequence
+(
+    value BIGINT NOT NULL
+);
+
+INSERT INTO change_log_sequence (value) VALUES (0);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION append_change(change_kind INT, change_operation INT, change_entity_id UUID, change_data JSONB) RETURNS VOID AS
+$$
+DECLARE
+    next_sequence BIGINT;
+BEGIN
+    -- The counter row stays locked until commit, so readers never see a gap that is filled later.
+    UPDATE change_log_sequence SET value = value + 1 RETURNING value INTO next_sequence;
+
+    INSERT INTO change_log (sequence, kind, operation, entity_id, data)
+    VALUES (next_sequence, change_kind, change_operation, change_entity_id, change_data);
+
+    PERFORM pg_notify('library_changes', next_sequence::text);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION author_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object('id', a.id, 'name', a.name, 'version', a.version)
+FROM author a
+WHERE a.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION change_operation(old_deleted_at TIMESTAMP, new_deleted_at TIMESTAMP, op TEXT) RETURNS INT AS
+$$
+BEGIN
+    IF op = 'INSERT' THEN
+        RETURN 1;
+    ELSIF op = 'DELETE' THEN
+        -- Purging rows that are already soft deleted is not a change for consumers.
+        RETURN CASE WHEN old_deleted_at IS NULL THEN 3 END;
+    ELSIF old_deleted_at IS NULL AND new_deleted_at IS NOT NULL THEN
+        RETURN 3;
+    ELSIF old_deleted_at IS NOT NULL AND new_deleted_at IS NULL THEN
+        RETURN 4;
+    ELSIF new_deleted_at IS NULL THEN
+        RETURN 2;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_book_change() RETURNS TRIGGER AS
+$$
+DECLARE
+    operation INT;
+    data      JSONB;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        operation := change_operation(OLD.deleted_at, NULL, TG_OP);
+        data := jsonb_build_object('id', OLD.id, 'name', OLD.name, 'author_ids', '[]'::jsonb,
+                                   'created_at', OLD.created_at, 'updated_at', OLD.updated_at,
+                                   'version', OLD.version);
+    ELSIF TG_OP = 'INSERT' THEN
+        operation := change_operation(NULL, NEW.deleted_at, TG_OP);
+        data := book_change_data(NEW.id);
+    ELSE
+        operation := change_operation(OLD.deleted_at, NEW.deleted_at, TG_OP);
+        data := book_change_data(NEW.id);
+    END IF;
+
+    -- The trigger is deferred, so the data reflects the book and its authors at commit.
+    IF operation IS NOT NULL AND data IS NOT NULL THEN
+        PERFORM append_change(1, operation, COALESCE(NEW.id, OLD.id), data);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_author_change() RETURNS TRIGGER AS
+$$
+DECLARE
+    operation INT;
+    data      JSONB;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        operation := change_operation(OLD.deleted_at, NULL, TG_OP);
+        data := jsonb_build_object('id', OLD.id, 'name', OLD.name, 'version', OLD.version);
+    ELSIF TG_OP = 'INSERT' THEN
+        operation := change_operation(NULL, NEW.deleted_at, TG_OP);
+        data := author_change_data(NEW.id);
+    ELSE
+        operation := change_operation(OLD.deleted_at, NEW.deleted_at, TG_OP);
+        data := author_change_data(NEW.id);
+    END IF;
+
+    IF operation IS NOT NULL AND data IS NOT NULL THEN
+        PERFORM append_change(2, operation, COALESCE(NEW.id, OLD.id), data);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE CONSTRAINT TRIGGER trigger_log_book_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON book
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION log_book_change();
+
+CREATE CONSTRAINT TRIGGER trigger_log_author_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON author
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION log_author_change();
+
+SELECT append_change(2, 1, a.id, author_change_data(a.id))
+FROM (SELECT id FROM author WHERE deleted_at IS NULL ORDER BY id) a;
+
+SELECT append_change(1, 1, b.id, book_change_data(b.id))
+FROM (SELECT id FROM book WHERE deleted_at IS NULL ORDER BY created_at, id) b;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_log_author_change ON author;
+DROP TRIGGER IF EXISTS trigger_log_book_change ON book;
+DROP FUNCTION IF EXISTS log_author_change;
+DROP FUNCTION IF EXISTS log_book_change;
+DROP FUNCTION IF EXISTS change_operation;
+DROP FUNCTION IF EXISTS author_change_data;
+DROP FUNCTION IF EXISTS book_change_data;
+DROP FUNCTION IF EXISTS append_change;
+DROP TABLE IF EXISTS change_log_sequence;
+DROP TABLE IF EXISTS change_log;
\ No newline at end of file
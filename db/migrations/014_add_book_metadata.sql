@@ -0,0 +1,65 @@
+-- +goose Up
+ALTER TABLE book ADD COLUMN isbn TEXT;
+ALTER TABLE book ADD COLUMN publication_year INT;
+ALTER TABLE book ADD COLUMN language TEXT;
+ALTER TABLE book ADD COLUMN page_count INT;
+ALTER TABLE book ADD COLUMN description TEXT;
+ALTER TABLE book ADD COLUMN subtitle TEXT;
+
+CREATE UNIQUE INDEX index_book_isbn ON book (isbn) WHERE isbn IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, '')
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+DROP INDEX IF EXISTS index_book_isbn;
+
+ALTER TABLE book DROP COLUMN subtitle;
+ALTER TABLE book DROP COLUMN description;
+ALTER TABLE book DROP COLUMN page_count;
+ALTER TABLE book DROP COLUMN language;
+ALTER TABLE book DROP COLUMN publication_year;
+ALTER TABLE book DROP COLUMN isbn;
\ No newline at end of file
@@ -0,0 +1,100 @@
+-- +goose Up
+CREATE TABLE publisher
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                           NOT NULL,
+    country    TEXT,
+    website    TEXT,
+    created_at TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP        DEFAULT now() NOT NULL,
+    version    BIGINT           DEFAULT 1     NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_publisher_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_publisher_timestamp
+    BEFORE UPDATE
+    ON publisher
+    FOR EACH ROW
+EXECUTE FUNCTION update_publisher_timestamp();
+
+CREATE OR REPLACE TRIGGER trigger_increment_publisher_version
+    BEFORE UPDATE
+    ON publisher
+    FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+ALTER TABLE book ADD COLUMN publisher_id UUID CONSTRAINT book_publisher_id_fkey REFERENCES publisher (id) ON DELETE SET NULL;
+
+CREATE INDEX index_book_publisher_id ON book (publisher_id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, '')
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, '')
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+DROP INDEX IF EXISTS index_book_publisher_id;
+
+ALTER TABLE book DROP COLUMN publisher_id;
+
+DROP TRIGGER IF EXISTS trigger_increment_publisher_version ON publisher;
+DROP TRIGGER IF EXISTS trigger_update_publisher_timestamp ON publisher;
+DROP FUNCTION IF EXISTS update_publisher_timestamp;
+DROP TABLE publisher;
\ No newline at end of file
@@ -0,0 +1,104 @@
+-- +goose Up
+CREATE TABLE genre
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                           NOT NULL,
+    parent_id  UUID CONSTRAINT genre_parent_id_fkey REFERENCES genre (id) ON DELETE RESTRICT,
+    created_at TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP        DEFAULT now() NOT NULL
+);
+
+CREATE INDEX index_genre_parent_id ON genre (parent_id);
+
+-- Sibling genres have distinct names, roots are compared against the nil uuid.
+CREATE UNIQUE INDEX index_genre_parent_name
+    ON genre (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), lower(name));
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_genre_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_genre_timestamp
+    BEFORE UPDATE
+    ON genre
+    FOR EACH ROW
+EXECUTE FUNCTION update_genre_timestamp();
+
+CREATE TABLE book_genre
+(
+    book_id  UUID REFERENCES book (id) ON DELETE CASCADE,
+    genre_id UUID CONSTRAINT book_genre_genre_id_fkey REFERENCES genre (id) ON DELETE CASCADE,
+    PRIMARY KEY (book_id, genre_id)
+);
+
+CREATE INDEX index_book_genre_genre_id ON book_genre (genre_id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, ''),
+               'genre_ids', COALESCE((SELECT jsonb_agg(bg.genre_id)
+                                      FROM book_genre bg
+                                      WHERE bg.book_id = b.id), '[]'::jsonb)
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, '')
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+DROP TABLE book_genre;
+
+DROP TRIGGER IF EXISTS trigger_update_genre_timestamp ON genre;
+DROP FUNCTION IF EXISTS update_genre_timestamp;
+DROP TABLE genre;
\ No newline at end of file
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE series
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name        TEXT                      NOT NULL,
+    description TEXT,
+    created_at  TIMESTAMP   DEFAULT now() NOT NULL,
+    updated_at  TIMESTAMP   DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_series_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_series_timestamp
+    BEFORE UPDATE
+    ON series
+    FOR EACH ROW
+EXECUTE FUNCTION update_series_timestamp();
+
+-- A book belongs to at most one series, the volume uniqueness is deferrable to let reorderings swap volumes.
+CREATE TABLE series_book
+(
+    book_id   UUID PRIMARY KEY CONSTRAINT series_book_book_id_fkey REFERENCES book (id) ON DELETE CASCADE,
+    series_id UUID    NOT NULL CONSTRAINT series_book_series_id_fkey REFERENCES series (id) ON DELETE CASCADE,
+    volume    NUMERIC NOT NULL CHECK (volume > 0),
+    CONSTRAINT series_book_volume_key UNIQUE (series_id, volume) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- +goose Down
+DROP TABLE series_book;
+DROP TRIGGER IF EXISTS trigger_update_series_timestamp ON series;
+DROP FUNCTION IF EXISTS update_series_timestamp;
+DROP TABLE series;
\ No newline at end of file
@@ -0,0 +1,143 @@
+-- +goose Up
+-- A work is the abstract title, books are its published editions and share the authors of the work.
+CREATE TABLE work
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                    NOT NULL,
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_work_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_work_timestamp
+    BEFORE UPDATE
+    ON work
+    FOR EACH ROW
+EXECUTE FUNCTION update_work_timestamp();
+
+CREATE TABLE author_work
+(
+    author_id UUID CONSTRAINT author_work_author_id_fkey REFERENCES author (id) ON DELETE CASCADE,
+    work_id   UUID CONSTRAINT author_work_work_id_fkey REFERENCES work (id) ON DELETE CASCADE,
+    PRIMARY KEY (author_id, work_id)
+);
+
+CREATE INDEX index_author_work_work_id ON author_work (work_id);
+
+-- Every existing book becomes a work with a single edition, the work reuses the id of the book.
+INSERT INTO work (id, name, created_at, updated_at)
+SELECT id, name, created_at, updated_at
+FROM book;
+
+INSERT INTO author_work (author_id, work_id)
+SELECT author_id, book_id
+FROM author_book;
+
+ALTER TABLE book ADD COLUMN work_id UUID CONSTRAINT book_work_id_fkey REFERENCES work (id);
+
+-- Linking the editions is not a change of the books, their versions, timestamps and change log stay intact.
+ALTER TABLE book DISABLE TRIGGER USER;
+
+UPDATE book SET work_id = id;
+
+ALTER TABLE book ENABLE TRIGGER USER;
+
+ALTER TABLE book ALTER COLUMN work_id SET NOT NULL;
+
+CREATE INDEX index_book_work_id ON book (work_id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(aw.author_id)
+                                       FROM author_work aw
+                                                JOIN author a ON a.id = aw.author_id
+                                       WHERE aw.work_id = b.work_id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, ''),
+               'genre_ids', COALESCE((SELECT jsonb_agg(bg.genre_id)
+                                      FROM book_genre bg
+                                      WHERE bg.book_id = b.id), '[]'::jsonb),
+               'work_id', b.work_id
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+DROP TABLE author_book;
+
+-- +goose Down
+CREATE TABLE author_book
+(
+    author_id UUID REFERENCES author (id) ON DELETE CASCADE,
+    book_id UUID REFERENCES book (id) ON DELETE CASCADE,
+    PRIMARY KEY (author_id, book_id)
+);
+
+CREATE INDEX index_author_book_book_id ON author_book (book_id);
+
+INSERT INTO author_book (author_id, book_id)
+SELECT aw.author_id, b.id
+FROM author_work aw
+         JOIN book b ON b.work_id = aw.work_id;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(ab.author_id)
+                                       FROM author_book ab
+                                                JOIN author a ON a.id = ab.author_id
+                                       WHERE ab.book_id = b.id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, ''),
+               'genre_ids', COALESCE((SELECT jsonb_agg(bg.genre_id)
+                                      FROM book_genre bg
+                                      WHERE bg.book_id = b.id), '[]'::jsonb)
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+DROP INDEX IF EXISTS index_book_work_id;
+
+ALTER TABLE book DROP COLUMN work_id;
+
+DROP TABLE author_work;
+DROP TRIGGER IF EXISTS trigger_update_work_timestamp ON work;
+DROP FUNCTION IF EXISTS update_work_timestamp;
+DROP TABLE work;
\ No newline at end of file
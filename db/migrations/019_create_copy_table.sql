@@ -0,0 +1,41 @@
+-- +goose Up
+-- Statuses: 1 - available, 2 - on loan, 3 - lost, 4 - damaged, 5 - in repair.
+CREATE TABLE copy
+(
+    id             UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    book_id        UUID      NOT NULL CONSTRAINT copy_book_id_fkey REFERENCES book (id) ON DELETE CASCADE,
+    barcode        TEXT      NOT NULL,
+    shelf_location TEXT,
+    acquired_on    DATE,
+    price_cents    BIGINT CHECK (price_cents >= 0),
+    status         INT       NOT NULL DEFAULT 1 CHECK (status BETWEEN 1 AND 5),
+    retired_at     TIMESTAMP,
+    created_at     TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Barcodes of retired copies are not reused.
+CREATE UNIQUE INDEX index_copy_barcode ON copy (barcode);
+
+CREATE INDEX index_copy_book_id ON copy (book_id) WHERE retired_at IS NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_copy_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_copy_timestamp
+    BEFORE UPDATE
+    ON copy
+    FOR EACH ROW
+EXECUTE FUNCTION update_copy_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_copy_timestamp ON copy;
+DROP FUNCTION IF EXISTS update_copy_timestamp;
+DROP TABLE copy;
\ No newline at end of file
@@ -0,0 +1,49 @@
+-- +goose Up
+-- Membership tiers: 1 - standard, 2 - student, 3 - premium.
+CREATE TABLE patron
+(
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name         TEXT                           NOT NULL,
+    email        TEXT,
+    phone        TEXT,
+    card_number  TEXT                           NOT NULL,
+    tier         INT              DEFAULT 1     NOT NULL CHECK (tier BETWEEN 1 AND 3),
+    expires_on   DATE                           NOT NULL,
+    blocked      BOOLEAN          DEFAULT false NOT NULL,
+    block_reason TEXT,
+    created_at   TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at   TIMESTAMP        DEFAULT now() NOT NULL,
+    version      BIGINT           DEFAULT 1     NOT NULL,
+    CONSTRAINT patron_contact_check CHECK (email IS NOT NULL OR phone IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX index_patron_card_number ON patron (card_number);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_patron_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_patron_timestamp
+    BEFORE UPDATE
+    ON patron
+    FOR EACH ROW
+EXECUTE FUNCTION update_patron_timestamp();
+
+CREATE OR REPLACE TRIGGER trigger_increment_patron_version
+    BEFORE UPDATE
+    ON patron
+    FOR EACH ROW
+EXECUTE FUNCTION increment_row_version();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_increment_patron_version ON patron;
+DROP TRIGGER IF EXISTS trigger_update_patron_timestamp ON patron;
+DROP FUNCTION IF EXISTS update_patron_timestamp;
+DROP INDEX IF EXISTS index_patron_card_number;
+DROP TABLE patron;
\ No newline at end of file
@@ -0,0 +1,64 @@
+-- +goose Up
+-- Loan rules of a membership tier, see the patron table for the tiers.
+CREATE TABLE loan_policy
+(
+    tier         INT PRIMARY KEY CHECK (tier BETWEEN 1 AND 3),
+    loan_days    INT                            NOT NULL CHECK (loan_days > 0),
+    max_loans    INT                            NOT NULL CHECK (max_loans >= 0),
+    max_renewals INT                            NOT NULL CHECK (max_renewals >= 0),
+    updated_at   TIMESTAMP        DEFAULT now() NOT NULL
+);
+
+INSERT INTO loan_policy (tier, loan_days, max_loans, max_renewals)
+VALUES (1, 21, 5, 2),
+       (2, 28, 10, 3),
+       (3, 42, 20, 5);
+
+CREATE TABLE loan
+(
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    copy_id        UUID                           NOT NULL CONSTRAINT loan_copy_id_fkey REFERENCES copy (id) ON DELETE CASCADE,
+    patron_id      UUID                           NOT NULL CONSTRAINT loan_patron_id_fkey REFERENCES patron (id) ON DELETE CASCADE,
+    checked_out_at TIMESTAMP        DEFAULT now() NOT NULL,
+    due_at         TIMESTAMP                      NOT NULL,
+    returned_at    TIMESTAMP,
+    renewals       INT              DEFAULT 0     NOT NULL,
+    created_at     TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at     TIMESTAMP        DEFAULT now() NOT NULL
+);
+
+-- A copy can be lent only once at a time.
+CREATE UNIQUE INDEX index_loan_active_copy ON loan (copy_id) WHERE returned_at IS NULL;
+
+CREATE INDEX index_loan_active_patron ON loan (patron_id) WHERE returned_at IS NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_loan_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_loan_timestamp
+    BEFORE UPDATE
+    ON loan
+    FOR EACH ROW
+EXECUTE FUNCTION update_loan_timestamp();
+
+CREATE OR REPLACE TRIGGER trigger_update_loan_policy_timestamp
+    BEFORE UPDATE
+    ON loan_policy
+    FOR EACH ROW
+EXECUTE FUNCTION update_loan_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_loan_policy_timestamp ON loan_policy;
+DROP TRIGGER IF EXISTS trigger_update_loan_timestamp ON loan;
+DROP FUNCTION IF EXISTS update_loan_timestamp;
+DROP INDEX IF EXISTS index_loan_active_patron;
+DROP INDEX IF EXISTS index_loan_active_copy;
+DROP TABLE loan;
+DROP TABLE loan_policy;
\ No newline at end of file
@@ -0,0 +1,62 @@
+-- +goose Up
+-- Copies held for pickup get the status 6 - on hold.
+ALTER TABLE copy DROP CONSTRAINT copy_status_check;
+ALTER TABLE copy ADD CONSTRAINT copy_status_check CHECK (status BETWEEN 1 AND 6);
+
+ALTER TABLE loan_policy ADD COLUMN hold_pickup_days INT DEFAULT 7 NOT NULL CHECK (hold_pickup_days > 0);
+
+-- Statuses: 1 - waiting, 2 - ready for pickup, 3 - fulfilled, 4 - cancelled, 5 - expired.
+CREATE TABLE hold
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    book_id     UUID                           NOT NULL CONSTRAINT hold_book_id_fkey REFERENCES book (id) ON DELETE CASCADE,
+    patron_id   UUID                           NOT NULL CONSTRAINT hold_patron_id_fkey REFERENCES patron (id) ON DELETE CASCADE,
+    copy_id     UUID CONSTRAINT hold_copy_id_fkey REFERENCES copy (id) ON DELETE SET NULL,
+    status      INT              DEFAULT 1     NOT NULL CHECK (status BETWEEN 1 AND 5),
+    ready_until TIMESTAMP,
+    created_at  TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at  TIMESTAMP        DEFAULT now() NOT NULL
+);
+
+-- A patron has at most one active hold per book.
+CREATE UNIQUE INDEX index_hold_active_patron_book ON hold (book_id, patron_id) WHERE status IN (1, 2);
+
+CREATE INDEX index_hold_queue ON hold (book_id, created_at, id) WHERE status = 1;
+
+CREATE INDEX index_hold_active_patron ON hold (patron_id) WHERE status IN (1, 2);
+
+CREATE UNIQUE INDEX index_hold_ready_copy ON hold (copy_id) WHERE status = 2;
+
+CREATE INDEX index_hold_ready_until ON hold (ready_until) WHERE status = 2;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_hold_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_hold_timestamp
+    BEFORE UPDATE
+    ON hold
+    FOR EACH ROW
+EXECUTE FUNCTION update_hold_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_hold_timestamp ON hold;
+DROP FUNCTION IF EXISTS update_hold_timestamp;
+DROP INDEX IF EXISTS index_hold_ready_until;
+DROP INDEX IF EXISTS index_hold_ready_copy;
+DROP INDEX IF EXISTS index_hold_active_patron;
+DROP INDEX IF EXISTS index_hold_queue;
+DROP INDEX IF EXISTS index_hold_active_patron_book;
+DROP TABLE hold;
+
+ALTER TABLE loan_policy DROP COLUMN hold_pickup_days;
+
+UPDATE copy SET status = 1 WHERE status = 6;
+ALTER TABLE copy DROP CONSTRAINT copy_status_check;
+ALTER TABLE copy ADD CONSTRAINT copy_status_check CHECK (status BETWEEN 1 AND 5);
\ No newline at end of file
@@ -0,0 +1,82 @@
+-- +goose Up
+-- Fine rules, the row of tier 0 holds the defaults and rows of membership tiers override some of them.
+CREATE TABLE fine_policy
+(
+    tier                  INT PRIMARY KEY CHECK (tier BETWEEN 0 AND 3),
+    daily_rate_cents      BIGINT CHECK (daily_rate_cents >= 0),
+    grace_days            INT CHECK (grace_days >= 0),
+    max_fine_cents        BIGINT CHECK (max_fine_cents >= 0),
+    block_threshold_cents BIGINT CHECK (block_threshold_cents >= 0),
+    updated_at            TIMESTAMP DEFAULT now() NOT NULL,
+    CONSTRAINT fine_policy_defaults_check CHECK (tier <> 0 OR (daily_rate_cents IS NOT NULL AND grace_days IS NOT NULL
+        AND max_fine_cents IS NOT NULL AND block_threshold_cents IS NOT NULL))
+);
+
+INSERT INTO fine_policy (tier, daily_rate_cents, grace_days, max_fine_cents, block_threshold_cents)
+VALUES (0, 25, 2, 2000, 1000),
+       (2, 10, NULL, 1000, NULL),
+       (3, NULL, 5, NULL, 2000);
+
+-- A fine of a loan, it accrues while the copy is out and becomes unpaid once it is returned.
+CREATE TABLE fine
+(
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    loan_id      UUID                    NOT NULL CONSTRAINT fine_loan_id_fkey REFERENCES loan (id) ON DELETE CASCADE,
+    patron_id    UUID                    NOT NULL CONSTRAINT fine_patron_id_fkey REFERENCES patron (id) ON DELETE CASCADE,
+    amount_cents BIGINT                  NOT NULL CHECK (amount_cents >= 0),
+    paid_cents   BIGINT    DEFAULT 0     NOT NULL CHECK (paid_cents >= 0),
+    status       INT                     NOT NULL CHECK (status BETWEEN 1 AND 4),
+    created_at   TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at   TIMESTAMP DEFAULT now() NOT NULL,
+    CONSTRAINT fine_paid_check CHECK (paid_cents <= amount_cents)
+);
+
+CREATE UNIQUE INDEX index_fine_loan ON fine (loan_id);
+
+CREATE INDEX index_fine_patron ON fine (patron_id, created_at);
+
+-- Audit trail of fines, amount_cents is the new total of an assessment and the sum of a payment or a waiver.
+CREATE TABLE fine_event
+(
+    id           BIGSERIAL PRIMARY KEY,
+    fine_id      UUID                    NOT NULL REFERENCES fine (id) ON DELETE CASCADE,
+    kind         INT                     NOT NULL CHECK (kind BETWEEN 1 AND 3),
+    amount_cents BIGINT                  NOT NULL,
+    note         TEXT      DEFAULT ''    NOT NULL,
+    created_at   TIMESTAMP DEFAULT now() NOT NULL
+);
+
+CREATE INDEX index_fine_event_fine ON fine_event (fine_id, id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_fine_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_fine_timestamp
+    BEFORE UPDATE
+    ON fine
+    FOR EACH ROW
+EXECUTE FUNCTION update_fine_timestamp();
+
+CREATE OR REPLACE TRIGGER trigger_update_fine_policy_timestamp
+    BEFORE UPDATE
+    ON fine_policy
+    FOR EACH ROW
+EXECUTE FUNCTION update_fine_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_fine_policy_timestamp ON fine_policy;
+DROP TRIGGER IF EXISTS trigger_update_fine_timestamp ON fine;
+DROP FUNCTION IF EXISTS update_fine_timestamp;
+DROP INDEX IF EXISTS index_fine_event_fine;
+DROP TABLE fine_event;
+DROP INDEX IF EXISTS index_fine_patron;
+DROP INDEX IF EXISTS index_fine_loan;
+DROP TABLE fine;
+DROP TABLE fine_policy;
\ No newline at end of file
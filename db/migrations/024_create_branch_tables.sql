@@ -0,0 +1,124 @@
+-- +goose Up
+CREATE TABLE branch
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                    NOT NULL,
+    address    TEXT,
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX index_branch_name ON branch (name);
+
+-- Copies and holds created before branches belong to the main branch.
+INSERT INTO branch (name)
+VALUES ('Main');
+
+-- Copies in transit between branches get the status 7 - in transit.
+ALTER TABLE copy DROP CONSTRAINT copy_status_check;
+ALTER TABLE copy ADD CONSTRAINT copy_status_check CHECK (status BETWEEN 1 AND 7);
+
+ALTER TABLE copy ADD COLUMN home_branch_id UUID CONSTRAINT copy_home_branch_id_fkey REFERENCES branch (id);
+ALTER TABLE copy ADD COLUMN current_branch_id UUID CONSTRAINT copy_current_branch_id_fkey REFERENCES branch (id);
+UPDATE copy
+SET home_branch_id    = (SELECT id FROM branch),
+    current_branch_id = (SELECT id FROM branch);
+ALTER TABLE copy ALTER COLUMN home_branch_id SET NOT NULL;
+ALTER TABLE copy ALTER COLUMN current_branch_id SET NOT NULL;
+
+CREATE INDEX index_copy_book_branch ON copy (book_id, current_branch_id) WHERE retired_at IS NULL;
+
+ALTER TABLE loan ADD COLUMN branch_id UUID CONSTRAINT loan_branch_id_fkey REFERENCES branch (id);
+
+-- Holds get the status 6 - in transit, the copy is being sent to the pickup branch.
+ALTER TABLE hold ADD COLUMN pickup_branch_id UUID CONSTRAINT hold_pickup_branch_id_fkey REFERENCES branch (id);
+UPDATE hold SET pickup_branch_id = (SELECT id FROM branch);
+ALTER TABLE hold ALTER COLUMN pickup_branch_id SET NOT NULL;
+
+ALTER TABLE hold DROP CONSTRAINT hold_status_check;
+ALTER TABLE hold ADD CONSTRAINT hold_status_check CHECK (status BETWEEN 1 AND 6);
+
+DROP INDEX index_hold_active_patron_book;
+CREATE UNIQUE INDEX index_hold_active_patron_book ON hold (book_id, patron_id) WHERE status IN (1, 2, 6);
+
+DROP INDEX index_hold_active_patron;
+CREATE INDEX index_hold_active_patron ON hold (patron_id) WHERE status IN (1, 2, 6);
+
+DROP INDEX index_hold_ready_copy;
+CREATE UNIQUE INDEX index_hold_ready_copy ON hold (copy_id) WHERE status IN (2, 6);
+
+-- Statuses: 1 - requested, 2 - in transit, 3 - received, 4 - cancelled.
+CREATE TABLE transfer
+(
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    copy_id        UUID                    NOT NULL CONSTRAINT transfer_copy_id_fkey REFERENCES copy (id) ON DELETE CASCADE,
+    from_branch_id UUID                    NOT NULL REFERENCES branch (id),
+    to_branch_id   UUID                    NOT NULL CONSTRAINT transfer_to_branch_id_fkey REFERENCES branch (id),
+    status         INT       DEFAULT 1     NOT NULL CHECK (status BETWEEN 1 AND 4),
+    shipped_at     TIMESTAMP,
+    received_at    TIMESTAMP,
+    created_at     TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at     TIMESTAMP DEFAULT now() NOT NULL,
+    CONSTRAINT transfer_branches_check CHECK (from_branch_id <> to_branch_id)
+);
+
+-- A copy is moved by one transfer at a time.
+CREATE UNIQUE INDEX index_transfer_active_copy ON transfer (copy_id) WHERE status IN (1, 2);
+
+CREATE INDEX index_transfer_from_branch ON transfer (from_branch_id, created_at) WHERE status IN (1, 2);
+
+CREATE INDEX index_transfer_to_branch ON transfer (to_branch_id, created_at) WHERE status IN (1, 2);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_branch_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_branch_timestamp
+    BEFORE UPDATE
+    ON branch
+    FOR EACH ROW
+EXECUTE FUNCTION update_branch_timestamp();
+
+CREATE OR REPLACE TRIGGER trigger_update_transfer_timestamp
+    BEFORE UPDATE
+    ON transfer
+    FOR EACH ROW
+EXECUTE FUNCTION update_branch_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_transfer_timestamp ON transfer;
+DROP TRIGGER IF EXISTS trigger_update_branch_timestamp ON branch;
+DROP FUNCTION IF EXISTS update_branch_timestamp;
+DROP INDEX IF EXISTS index_transfer_to_branch;
+DROP INDEX IF EXISTS index_transfer_from_branch;
+DROP INDEX IF EXISTS index_transfer_active_copy;
+DROP TABLE transfer;
+
+UPDATE hold SET status = 1, copy_id = NULL WHERE status = 6;
+DROP INDEX index_hold_ready_copy;
+CREATE UNIQUE INDEX index_hold_ready_copy ON hold (copy_id) WHERE status = 2;
+DROP INDEX index_hold_active_patron;
+CREATE INDEX index_hold_active_patron ON hold (patron_id) WHERE status IN (1, 2);
+DROP INDEX index_hold_active_patron_book;
+CREATE UNIQUE INDEX index_hold_active_patron_book ON hold (book_id, patron_id) WHERE status IN (1, 2);
+ALTER TABLE hold DROP CONSTRAINT hold_status_check;
+ALTER TABLE hold ADD CONSTRAINT hold_status_check CHECK (status BETWEEN 1 AND 5);
+ALTER TABLE hold DROP COLUMN pickup_branch_id;
+
+ALTER TABLE loan DROP COLUMN branch_id;
+
+DROP INDEX IF EXISTS index_copy_book_branch;
+ALTER TABLE copy DROP COLUMN current_branch_id;
+ALTER TABLE copy DROP COLUMN home_branch_id;
+UPDATE copy SET status = 1 WHERE status = 7;
+ALTER TABLE copy DROP CONSTRAINT copy_status_check;
+ALTER TABLE copy ADD CONSTRAINT copy_status_check CHECK (status BETWEEN 1 AND 6);
+
+DROP INDEX IF EXISTS index_branch_name;
+DROP TABLE branch;
\ No newline at end of file
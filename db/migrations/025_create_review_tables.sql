@@ -0,0 +1,51 @@
+-- +goose Up
+-- Statuses: 1 - pending, 2 - approved, 3 - rejected.
+CREATE TABLE review
+(
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    book_id         UUID                    NOT NULL CONSTRAINT review_book_id_fkey REFERENCES book (id) ON DELETE CASCADE,
+    patron_id       UUID                    NOT NULL CONSTRAINT review_patron_id_fkey REFERENCES patron (id) ON DELETE CASCADE,
+    rating          INT                     NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text            TEXT,
+    status          INT       DEFAULT 1     NOT NULL CHECK (status BETWEEN 1 AND 3),
+    moderation_note TEXT,
+    created_at      TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at      TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- A patron reviews a book once.
+CREATE UNIQUE INDEX index_review_book_patron ON review (book_id, patron_id);
+
+CREATE INDEX index_review_book_status ON review (book_id, status, created_at, id);
+
+-- Aggregates of approved reviews, updated together with the moderation of a review.
+CREATE TABLE book_rating
+(
+    book_id      UUID PRIMARY KEY REFERENCES book (id) ON DELETE CASCADE,
+    rating_sum   BIGINT DEFAULT 0 NOT NULL CHECK (rating_sum >= 0),
+    review_count INT    DEFAULT 0 NOT NULL CHECK (review_count >= 0)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_review_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_review_timestamp
+    BEFORE UPDATE
+    ON review
+    FOR EACH ROW
+EXECUTE FUNCTION update_review_timestamp();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_review_timestamp ON review;
+DROP FUNCTION IF EXISTS update_review_timestamp;
+DROP TABLE book_rating;
+DROP INDEX IF EXISTS index_review_book_status;
+DROP INDEX IF EXISTS index_review_book_patron;
+DROP TABLE review;
\ No newline at end of file
@@ -0,0 +1,87 @@
+-- +goose Up
+-- Roles of the contributors: 1 - author, 2 - translator, 3 - illustrator, 4 - editor.
+ALTER TABLE author_work ADD COLUMN role SMALLINT DEFAULT 1 NOT NULL CONSTRAINT author_work_role_check CHECK (role BETWEEN 1 AND 4);
+ALTER TABLE author_work ADD COLUMN position INT DEFAULT 0 NOT NULL;
+
+-- Existing contributors are numbered in a stable order, there was no display order before.
+UPDATE author_work aw
+SET position = numbered.position
+FROM (SELECT author_id, work_id, row_number() OVER (PARTITION BY work_id ORDER BY author_id) AS position
+      FROM author_work) numbered
+WHERE aw.author_id = numbered.author_id
+  AND aw.work_id = numbered.work_id;
+
+-- Contributors are serialized in display order like GetBookInfo returns them.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(aw.author_id ORDER BY aw.position, aw.author_id)
+                                       FROM author_work aw
+                                                JOIN author a ON a.id = aw.author_id
+                                       WHERE aw.work_id = b.work_id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'contributors', COALESCE((SELECT jsonb_agg(jsonb_build_object('author_id', aw.author_id,
+                                                                             'role', aw.role,
+                                                                             'position', aw.position)
+                                                          ORDER BY aw.position, aw.author_id)
+                                         FROM author_work aw
+                                                  JOIN author a ON a.id = aw.author_id
+                                         WHERE aw.work_id = b.work_id
+                                           AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, ''),
+               'genre_ids', COALESCE((SELECT jsonb_agg(bg.genre_id)
+                                      FROM book_genre bg
+                                      WHERE bg.book_id = b.id), '[]'::jsonb),
+               'work_id', b.work_id
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION book_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', b.id,
+               'name', b.name,
+               'author_ids', COALESCE((SELECT jsonb_agg(aw.author_id)
+                                       FROM author_work aw
+                                                JOIN author a ON a.id = aw.author_id
+                                       WHERE aw.work_id = b.work_id
+                                         AND a.deleted_at IS NULL), '[]'::jsonb),
+               'created_at', b.created_at,
+               'updated_at', b.updated_at,
+               'version', b.version,
+               'isbn', COALESCE(b.isbn, ''),
+               'publication_year', COALESCE(b.publication_year, 0),
+               'language', COALESCE(b.language, ''),
+               'page_count', COALESCE(b.page_count, 0),
+               'description', COALESCE(b.description, ''),
+               'subtitle', COALESCE(b.subtitle, ''),
+               'publisher_id', COALESCE(b.publisher_id::text, ''),
+               'genre_ids', COALESCE((SELECT jsonb_agg(bg.genre_id)
+                                      FROM book_genre bg
+                                      WHERE bg.book_id = b.id), '[]'::jsonb),
+               'work_id', b.work_id
+       )
+FROM book b
+WHERE b.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+ALTER TABLE author_work DROP COLUMN position;
+ALTER TABLE author_work DROP COLUMN role;
\ No newline at end of file
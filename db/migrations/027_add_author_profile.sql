@@ -0,0 +1,83 @@
+-- +goose Up
+ALTER TABLE author ADD COLUMN birth_date DATE;
+ALTER TABLE author ADD COLUMN death_date DATE;
+ALTER TABLE author ADD COLUMN nationality TEXT;
+ALTER TABLE author ADD COLUMN biography TEXT;
+-- Alternative names and pseudonyms, lookups by name also match them.
+ALTER TABLE author ADD COLUMN aliases TEXT[] DEFAULT '{}' NOT NULL;
+
+ALTER TABLE author ADD CONSTRAINT author_life_dates_check CHECK (death_date >= birth_date);
+
+CREATE INDEX index_author_aliases ON author USING GIN (aliases);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name),
+                                    concat_ws(' ', NEW.name, array_to_string(NEW.aliases, ' ')));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_author_search_vector
+    BEFORE INSERT OR UPDATE OF name, aliases
+    ON author
+    FOR EACH ROW
+EXECUTE FUNCTION update_author_search_vector();
+
+-- Dates are serialized as timestamps like the other times of the change log.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION author_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object(
+               'id', a.id,
+               'name', a.name,
+               'version', a.version,
+               'birth_date', a.birth_date::timestamp,
+               'death_date', a.death_date::timestamp,
+               'nationality', COALESCE(a.nationality, ''),
+               'biography', COALESCE(a.biography, ''),
+               'aliases', to_jsonb(a.aliases)
+       )
+FROM author a
+WHERE a.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION author_change_data(target_id UUID) RETURNS JSONB AS
+$$
+SELECT jsonb_build_object('id', a.id, 'name', a.name, 'version', a.version)
+FROM author a
+WHERE a.id = target_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name), NEW.name);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_author_search_vector
+    BEFORE INSERT OR UPDATE OF name
+    ON author
+    FOR EACH ROW
+EXECUTE FUNCTION update_author_search_vector();
+
+DROP INDEX IF EXISTS index_author_aliases;
+
+ALTER TABLE author DROP CONSTRAINT author_life_dates_check;
+
+ALTER TABLE author DROP COLUMN aliases;
+ALTER TABLE author DROP COLUMN biography;
+ALTER TABLE author DROP COLUMN nationality;
+ALTER TABLE author DROP COLUMN death_date;
+ALTER TABLE author DROP COLUMN birth_date;
\ No newline at end of file
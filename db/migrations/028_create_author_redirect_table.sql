@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX index_author_name_trgm ON author USING gin (name gin_trgm_ops);
+
+-- Ids of authors merged into another one, lookups by them resolve to the author they were merged into.
+CREATE TABLE author_redirect
+(
+    id         UUID PRIMARY KEY,
+    author_id  UUID                    NOT NULL CONSTRAINT author_redirect_author_id_fkey REFERENCES author (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+CREATE INDEX index_author_redirect_author_id ON author_redirect (author_id);
+
+-- +goose Down
+DROP TABLE author_redirect;
+
+DROP INDEX index_author_name_trgm;
+
+DROP EXTENSION IF EXISTS pg_trgm;
\ No newline at end of file
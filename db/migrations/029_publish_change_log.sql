@@ -0,0 +1,92 @@
+-- +goose Up
+-- Writers no longer take a global counter row. Entries get their sequence when they are published by a reader, only
+-- entries of transactions older than every running one are published, so later commits never fill a gap below an
+-- already read sequence.
+ALTER TABLE change_log DROP CONSTRAINT change_log_pkey;
+ALTER TABLE change_log ALTER COLUMN sequence DROP NOT NULL;
+ALTER TABLE change_log ADD COLUMN id BIGSERIAL PRIMARY KEY;
+ALTER TABLE change_log ADD COLUMN transaction_id XID8 DEFAULT pg_current_xact_id() NOT NULL;
+
+CREATE UNIQUE INDEX index_change_log_sequence ON change_log (sequence);
+
+CREATE INDEX index_change_log_unpublished ON change_log (transaction_id, id) WHERE sequence IS NULL;
+
+CREATE SEQUENCE change_log_published_sequence;
+
+SELECT setval('change_log_published_sequence', GREATEST(value, 1), value > 0) FROM change_log_sequence;
+
+DROP TABLE change_log_sequence;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION append_change(change_kind INT, change_operation INT, change_entity_id UUID, change_data JSONB) RETURNS VOID AS
+$$
+BEGIN
+    INSERT INTO change_log (kind, operation, entity_id, data)
+    VALUES (change_kind, change_operation, change_entity_id, change_data);
+
+    PERFORM pg_notify('library_changes', '');
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION publish_changes() RETURNS VOID AS
+$$
+BEGIN
+    -- Publishers take turns until commit, so the sequences of one turn are all above the committed ones.
+    PERFORM pg_advisory_xact_lock(hashtext('change_log'));
+
+    -- Transactions below the snapshot xmin are finished, their entries can no longer appear.
+    UPDATE change_log c
+    SET sequence = p.sequence
+    FROM (SELECT pending.id, nextval('change_log_published_sequence') AS sequence
+          FROM (SELECT id
+                FROM change_log
+                WHERE sequence IS NULL
+                  AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())
+                ORDER BY transaction_id, id) pending) p
+    WHERE c.id = p.id;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+CREATE TABLE change_log_sequence
+(
+    value BIGINT NOT NULL
+);
+
+SELECT publish_changes();
+
+INSERT INTO change_log_sequence (value) SELECT COALESCE(max(sequence), 0) FROM change_log;
+
+DELETE FROM change_log WHERE sequence IS NULL;
+
+DROP FUNCTION IF EXISTS publish_changes;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION append_change(change_kind INT, change_operation INT, change_entity_id UUID, change_data JSONB) RETURNS VOID AS
+$$
+DECLARE
+    next_sequence BIGINT;
+BEGIN
+    -- The counter row stays locked until commit, so readers never see a gap that is filled later.
+    UPDATE change_log_sequence SET value = value + 1 RETURNING value INTO next_sequence;
+
+    INSERT INTO change_log (sequence, kind, operation, entity_id, data)
+    VALUES (next_sequence, change_kind, change_operation, change_entity_id, change_data);
+
+    PERFORM pg_notify('library_changes', next_sequence::text);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP SEQUENCE change_log_published_sequence;
+
+DROP INDEX IF EXISTS index_change_log_unpublished;
+DROP INDEX IF EXISTS index_change_log_sequence;
+
+ALTER TABLE change_log DROP COLUMN transaction_id;
+ALTER TABLE change_log DROP COLUMN id;
+ALTER TABLE change_log ALTER COLUMN sequence SET NOT NULL;
+ALTER TABLE change_log ADD PRIMARY KEY (sequence);
\ No newline at end of file
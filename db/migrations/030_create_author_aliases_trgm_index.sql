@@ -0,0 +1,20 @@
+-- +goose Up
+-- array_to_string is only stable, an index expression needs an immutable function.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION author_aliases_text(aliases TEXT[]) RETURNS TEXT
+    LANGUAGE sql
+    IMMUTABLE
+    PARALLEL SAFE
+AS
+$$
+SELECT lower(array_to_string(aliases, E'\n'))
+$$;
+-- +goose StatementEnd
+
+-- Serves alias prefix and case-insensitive lookups in ListAuthors, exact ones use index_author_aliases.
+CREATE INDEX index_author_aliases_trgm ON author USING gin (author_aliases_text(aliases) gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX index_author_aliases_trgm;
+
+DROP FUNCTION author_aliases_text(TEXT[]);
\ No newline at end of file
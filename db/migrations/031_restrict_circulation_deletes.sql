@@ -0,0 +1,20 @@
+-- +goose Up
+-- Deleting a book or a copy must never take loans, fines or holds with it, purges skip books still referenced.
+ALTER TABLE copy DROP CONSTRAINT copy_book_id_fkey;
+ALTER TABLE copy ADD CONSTRAINT copy_book_id_fkey FOREIGN KEY (book_id) REFERENCES book (id) ON DELETE RESTRICT;
+
+ALTER TABLE loan DROP CONSTRAINT loan_copy_id_fkey;
+ALTER TABLE loan ADD CONSTRAINT loan_copy_id_fkey FOREIGN KEY (copy_id) REFERENCES copy (id) ON DELETE RESTRICT;
+
+ALTER TABLE hold DROP CONSTRAINT hold_book_id_fkey;
+ALTER TABLE hold ADD CONSTRAINT hold_book_id_fkey FOREIGN KEY (book_id) REFERENCES book (id) ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE hold DROP CONSTRAINT hold_book_id_fkey;
+ALTER TABLE hold ADD CONSTRAINT hold_book_id_fkey FOREIGN KEY (book_id) REFERENCES book (id) ON DELETE CASCADE;
+
+ALTER TABLE loan DROP CONSTRAINT loan_copy_id_fkey;
+ALTER TABLE loan ADD CONSTRAINT loan_copy_id_fkey FOREIGN KEY (copy_id) REFERENCES copy (id) ON DELETE CASCADE;
+
+ALTER TABLE copy DROP CONSTRAINT copy_book_id_fkey;
+ALTER TABLE copy ADD CONSTRAINT copy_book_id_fkey FOREIGN KEY (book_id) REFERENCES book (id) ON DELETE CASCADE;
\ No newline at end of file
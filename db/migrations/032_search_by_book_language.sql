@@ -0,0 +1,110 @@
+-- +goose Up
+-- The configuration of a book follows the primary subtag of its language, books in other languages and author
+-- names are not stemmed.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION library_language_config(language TEXT) RETURNS regconfig AS
+$$
+SELECT (CASE lower(split_part(language, '-', 1))
+            WHEN 'da' THEN 'danish'
+            WHEN 'de' THEN 'german'
+            WHEN 'en' THEN 'english'
+            WHEN 'es' THEN 'spanish'
+            WHEN 'fi' THEN 'finnish'
+            WHEN 'fr' THEN 'french'
+            WHEN 'hu' THEN 'hungarian'
+            WHEN 'it' THEN 'italian'
+            WHEN 'nb' THEN 'norwegian'
+            WHEN 'nl' THEN 'dutch'
+            WHEN 'nn' THEN 'norwegian'
+            WHEN 'no' THEN 'norwegian'
+            WHEN 'pt' THEN 'portuguese'
+            WHEN 'ro' THEN 'romanian'
+            WHEN 'ru' THEN 'russian'
+            WHEN 'sv' THEN 'swedish'
+            WHEN 'tr' THEN 'turkish'
+            ELSE 'simple' END)::regconfig;
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- Every configuration library_language_config returns, a query is parsed with each of them.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION library_search_configs() RETURNS SETOF regconfig AS
+$$
+SELECT unnest(ARRAY ['simple', 'danish', 'german', 'english', 'spanish', 'finnish', 'french', 'hungarian', 'italian',
+    'norwegian', 'dutch', 'portuguese', 'romanian', 'russian', 'swedish', 'turkish']::regconfig[]);
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_language_config(NEW.language), NEW.name);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_search_vector
+    BEFORE INSERT OR UPDATE OF name, language
+    ON book
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_search_vector();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector('simple', concat_ws(' ', NEW.name, array_to_string(NEW.aliases, ' ')));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+ALTER TABLE book DISABLE TRIGGER USER;
+UPDATE book SET search_vector = to_tsvector(library_language_config(language), name);
+ALTER TABLE book ENABLE TRIGGER USER;
+
+ALTER TABLE author DISABLE TRIGGER USER;
+UPDATE author SET search_vector = to_tsvector('simple', concat_ws(' ', name, array_to_string(aliases, ' ')));
+ALTER TABLE author ENABLE TRIGGER USER;
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name),
+                                    concat_ws(' ', NEW.name, array_to_string(NEW.aliases, ' ')));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_search_vector() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.search_vector = to_tsvector(library_search_config(NEW.name), NEW.name);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_search_vector
+    BEFORE INSERT OR UPDATE OF name
+    ON book
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_search_vector();
+
+ALTER TABLE book DISABLE TRIGGER USER;
+UPDATE book SET search_vector = to_tsvector(library_search_config(name), name);
+ALTER TABLE book ENABLE TRIGGER USER;
+
+ALTER TABLE author DISABLE TRIGGER USER;
+UPDATE author
+SET search_vector = to_tsvector(library_search_config(name), concat_ws(' ', name, array_to_string(aliases, ' ')));
+ALTER TABLE author ENABLE TRIGGER USER;
+
+DROP FUNCTION IF EXISTS library_search_configs;
+DROP FUNCTION IF EXISTS library_language_config;
\ No newline at end of file
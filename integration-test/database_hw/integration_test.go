@@ -42,7 +42,8 @@ var db *sql.DB
 const (
 	authorTableName     = "author"
 	bookTableName       = "book"
-	authorBookTableName = "author_book"
+	authorWorkTableName = "author_work"
+	workTableName       = "work"
 )
 
 func TestMain(m *testing.M) {
@@ -81,7 +82,10 @@ func cleanUp(t *testing.T) {
 	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", bookTableName))
 	require.NoError(t, err)
 
-	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorBookTableName))
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorWorkTableName))
+	require.NoError(t, err)
+
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", workTableName))
 	require.NoError(t, err)
 }
 
@@ -43,7 +43,8 @@ var db *sql.DB
 const (
 	authorTableName     = "author"
 	bookTableName       = "book"
-	authorBookTableName = "author_book"
+	authorWorkTableName = "author_work"
+	workTableName       = "work"
 	outboxTable         = "outbox"
 )
 
@@ -83,10 +84,13 @@ func cleanUp(t *testing.T) {
 	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", bookTableName))
 	require.NoError(t, err)
 
-	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorBookTableName))
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorWorkTableName))
 	require.NoError(t, err)
 
-	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorBookTableName))
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", workTableName))
+	require.NoError(t, err)
+
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", authorWorkTableName))
 	require.NoError(t, err)
 
 	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", outboxTable))
@@ -274,7 +278,7 @@ func TestOutboxRetries(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		authorID := string(data)
+		authorID := readChangeID(t, data)
 
 		authorMx.Lock()
 		defer authorMx.Unlock()
@@ -298,7 +302,7 @@ func TestOutboxRetries(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		bookID := string(data)
+		bookID := readChangeID(t, data)
 
 		bookMx.Lock()
 		defer bookMx.Unlock()
@@ -417,7 +421,7 @@ func TestOutboxConsistency(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		authorID := string(data)
+		authorID := readChangeID(t, data)
 
 		authorMx.Lock()
 		defer authorMx.Unlock()
@@ -435,7 +439,7 @@ func TestOutboxConsistency(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		bookID := string(data)
+		bookID := readChangeID(t, data)
 
 		bookMx.Lock()
 		defer bookMx.Unlock()
@@ -579,7 +583,7 @@ func TestOutbox(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		authorID := string(data)
+		authorID := readChangeID(t, data)
 
 		authorMx.Lock()
 		defer authorMx.Unlock()
@@ -598,7 +602,7 @@ func TestOutbox(t *testing.T) {
 		data, err := io.ReadAll(request.Body)
 		require.NoError(t, err)
 
-		bookID := string(data)
+		bookID := readChangeID(t, data)
 
 		bookMx.Lock()
 		defer bookMx.Unlock()
@@ -700,6 +704,19 @@ func TestOutbox(t *testing.T) {
 	require.Equal(t, bookCount, int(bookCounter.Load()))
 }
 
+func readChangeID(t *testing.T, data []byte) string {
+	t.Helper()
+
+	var message struct {
+		ID        string `json:"id"`
+		Operation string `json:"operation"`
+	}
+	require.NoError(t, json.Unmarshal(data, &message))
+	require.Equal(t, "upsert", message.Operation)
+
+	return message.ID
+}
+
 func defaultOutboxConfiguration(authorURL, bookURL string) *outBoxConfiguration {
 	return &outBoxConfiguration{
 		Workers:         2,
@@ -21,8 +21,11 @@ import (
 	generated "github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/controller"
 	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/fineassessment"
+	"github.com/project/library/internal/usecase/holdexpiry"
 	"github.com/project/library/internal/usecase/library"
 	"github.com/project/library/internal/usecase/outbox"
+	"github.com/project/library/internal/usecase/purge"
 	"github.com/project/library/internal/usecase/repository"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -40,6 +43,11 @@ const transportTLSHandshakeTimeout = 15
 const transportExpectContinueTimeout = 2
 const httpMinErrorStatus = 400
 
+const (
+	outboxOperationUpsert = "upsert"
+	outboxOperationDelete = "delete"
+)
+
 func Run(logger *zap.Logger, cfg *config.Config) {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -57,13 +65,32 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 
 	repo := repository.NewPostgresRepository(logger, dbPool)
 	outboxRepository := repository.NewOutbox(dbPool)
+	changeLogRepository := repository.NewChangeLog(dbPool, logger)
+	go changeLogRepository.Listen(ctx)
 
 	transactor := repository.NewTransactor(dbPool, logger)
 	go runOutbox(ctx, cfg, logger, outboxRepository, transactor)
 
-	useCases := library.New(logger, transactor, outboxRepository, repo, repo)
+	if cfg.Purge.Enabled {
+		purgeService := purge.New(logger, repo, repo)
+		go purgeService.Start(ctx, cfg.Purge.Interval, cfg.Purge.Retention)
+	}
+
+	if cfg.FineAssessment.Enabled {
+		fineAssessmentService := fineassessment.New(logger, repo)
+		go fineAssessmentService.Start(ctx, cfg.FineAssessment.Interval)
+	}
+
+	useCases := library.New(logger, transactor, outboxRepository, repo, repo, changeLogRepository,
+		repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo)
 
-	ctrl := controller.New(logger, useCases, useCases)
+	if cfg.HoldExpiry.Enabled {
+		holdExpiryService := holdexpiry.New(logger, useCases)
+		go holdExpiryService.Start(ctx, cfg.HoldExpiry.Interval)
+	}
+
+	ctrl := controller.New(logger, useCases, useCases, useCases, useCases,
+		useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases)
 
 	go runRest(ctx, cfg, logger)
 	go runGrpc(cfg, logger, ctrl)
@@ -99,7 +126,15 @@ func runOutbox(
 	client := new(http.Client)
 	client.Transport = transport
 
-	globalHandler := globalOutboxHandler(client, cfg.Outbox.BookSendURL, cfg.Outbox.AuthorSendURL, logger)
+	globalHandler := globalOutboxHandler(
+		client,
+		cfg.Outbox.BookSendURL,
+		cfg.Outbox.AuthorSendURL,
+		cfg.Outbox.PublisherSendURL,
+		cfg.Outbox.PatronSendURL,
+		cfg.Outbox.HoldSendURL,
+		logger,
+	)
 	outboxService := outbox.New(logger, outboxRepository, globalHandler, cfg, transactor)
 
 	outboxService.Start(
@@ -115,14 +150,27 @@ func globalOutboxHandler(
 	client *http.Client,
 	bookURL string,
 	authorURL string,
+	publisherURL string,
+	patronURL string,
+	holdURL string,
 	logger *zap.Logger,
 ) outbox.GlobalHandler {
 	return func(kind repository.OutboxKind) (outbox.KindHandler, error) {
 		switch kind {
 		case repository.OutboxKindBook:
-			return bookOutboxHandler(client, bookURL, logger), nil
+			return bookOutboxHandler(client, bookURL, outboxOperationUpsert, logger), nil
+		case repository.OutboxKindBookDeleted:
+			return bookOutboxHandler(client, bookURL, outboxOperationDelete, logger), nil
 		case repository.OutboxKindAuthor:
-			return authorOutboxHandler(client, authorURL, logger), nil
+			return authorOutboxHandler(client, authorURL, outboxOperationUpsert, logger), nil
+		case repository.OutboxKindAuthorDeleted:
+			return authorOutboxHandler(client, authorURL, outboxOperationDelete, logger), nil
+		case repository.OutboxKindPublisher:
+			return publisherOutboxHandler(client, publisherURL, logger), nil
+		case repository.OutboxKindPatron:
+			return patronOutboxHandler(client, patronURL, logger), nil
+		case repository.OutboxKindHoldReady:
+			return holdOutboxHandler(client, holdURL, logger), nil
 		default:
 			return nil, fmt.Errorf("unsupported outbox kind: %d", kind)
 		}
@@ -130,7 +178,26 @@ func globalOutboxHandler(
 }
 
 func SendID(client *http.Client, url string, id string, logger *zap.Logger) error {
-	resp, err := client.Post(url, "text/plain", strings.NewReader(id))
+	return send(client, url, "text/plain", id, logger)
+}
+
+// ChangeMessage is the body of book and author notifications, Operation tells deletions from creates and updates.
+type ChangeMessage struct {
+	ID        string `json:"id"`
+	Operation string `json:"operation"`
+}
+
+func SendChange(client *http.Client, url string, id string, operation string, logger *zap.Logger) error {
+	body, err := json.Marshal(ChangeMessage{ID: id, Operation: operation})
+	if err != nil {
+		return fmt.Errorf("can not serialize change message: %w", err)
+	}
+
+	return send(client, url, "application/json", string(body), logger)
+}
+
+func send(client *http.Client, url string, contentType string, body string, logger *zap.Logger) error {
+	resp, err := client.Post(url, contentType, strings.NewReader(body))
 
 	if err != nil {
 		return fmt.Errorf("error while processing post request: %w", err)
@@ -150,7 +217,7 @@ func SendID(client *http.Client, url string, id string, logger *zap.Logger) erro
 	return nil
 }
 
-func bookOutboxHandler(client *http.Client, url string, logger *zap.Logger) outbox.KindHandler {
+func bookOutboxHandler(client *http.Client, url string, operation string, logger *zap.Logger) outbox.KindHandler {
 	return func(_ context.Context, data []byte) error {
 		book := entity.Book{}
 		err := json.Unmarshal(data, &book)
@@ -160,11 +227,11 @@ func bookOutboxHandler(client *http.Client, url string, logger *zap.Logger) outb
 			return fmt.Errorf("can not deserialize data in book outbox handler: %w", err)
 		}
 
-		return SendID(client, url, book.ID, logger)
+		return SendChange(client, url, book.ID, operation, logger)
 	}
 }
 
-func authorOutboxHandler(client *http.Client, url string, logger *zap.Logger) outbox.KindHandler {
+func authorOutboxHandler(client *http.Client, url string, operation string, logger *zap.Logger) outbox.KindHandler {
 	return func(_ context.Context, data []byte) error {
 		author := entity.Author{}
 		err := json.Unmarshal(data, &author)
@@ -174,12 +241,54 @@ func authorOutboxHandler(client *http.Client, url string, logger *zap.Logger) ou
 			return fmt.Errorf("can not deserialize data in author outbox handler: %w", err)
 		}
 
-		return SendID(client, url, author.ID, logger)
+		return SendChange(client, url, author.ID, operation, logger)
+	}
+}
+
+func publisherOutboxHandler(client *http.Client, url string, logger *zap.Logger) outbox.KindHandler {
+	return func(_ context.Context, data []byte) error {
+		publisher := entity.Publisher{}
+		err := json.Unmarshal(data, &publisher)
+
+		if err != nil {
+			logger.Error("error while deserializing data in publisher.")
+			return fmt.Errorf("can not deserialize data in publisher outbox handler: %w", err)
+		}
+
+		return SendID(client, url, publisher.ID, logger)
+	}
+}
+
+func patronOutboxHandler(client *http.Client, url string, logger *zap.Logger) outbox.KindHandler {
+	return func(_ context.Context, data []byte) error {
+		patron := entity.Patron{}
+		err := json.Unmarshal(data, &patron)
+
+		if err != nil {
+			logger.Error("error while deserializing data in patron.")
+			return fmt.Errorf("can not deserialize data in patron outbox handler: %w", err)
+		}
+
+		return SendID(client, url, patron.ID, logger)
+	}
+}
+
+func holdOutboxHandler(client *http.Client, url string, logger *zap.Logger) outbox.KindHandler {
+	return func(_ context.Context, data []byte) error {
+		hold := entity.Hold{}
+		err := json.Unmarshal(data, &hold)
+
+		if err != nil {
+			logger.Error("error while deserializing data in hold.")
+			return fmt.Errorf("can not deserialize data in hold outbox handler: %w", err)
+		}
+
+		return SendID(client, url, hold.ID, logger)
 	}
 }
 
 func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
-	mux := grpcruntime.NewServeMux()
+	mux := grpcruntime.NewServeMux(grpcruntime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	address := "localhost:" + cfg.GRPC.Port
@@ -198,6 +307,15 @@ func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
 	}
 }
 
+// gatewayHeaderMatcher also forwards If-Match, which is checked against etags on updates.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "If-Match") {
+		return "if-match", true
+	}
+
+	return grpcruntime.DefaultHeaderMatcher(key)
+}
+
 func runGrpc(cfg *config.Config, logger *zap.Logger, libraryService generated.LibraryServer) {
 	port := ":" + cfg.GRPC.Port
 	lis, err := net.Listen("tcp", port)
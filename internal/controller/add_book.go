@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 
 	"github.com/project/library/generated/api/library"
 	"go.uber.org/zap"
@@ -12,7 +13,7 @@ import (
 func (i *implementation) AddBook(ctx context.Context, request *library.AddBookRequest) (*library.AddBookResponse, error) {
 	i.logger.Info("Validating add book request")
 
-	if err := request.ValidateAll(); err != nil {
+	if err := validateAddBookRequest(request); err != nil {
 		i.logger.Error("Error during validating add book request.", zap.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -28,3 +29,35 @@ func (i *implementation) AddBook(ctx context.Context, request *library.AddBookRe
 
 	return book, nil
 }
+
+var (
+	errEditionAuthors = errors.New("authors of an edition are taken from its work")
+	errAuthorsTwice   = errors.New("only one of author_ids and contributors can be set")
+)
+
+// validateAddBookRequest also normalizes the isbn of the request.
+func validateAddBookRequest(request *library.AddBookRequest) error {
+	if err := request.ValidateAll(); err != nil {
+		return err
+	}
+
+	if len(request.GetAuthorIds()) > 0 && len(request.GetContributors()) > 0 {
+		return errAuthorsTwice
+	}
+
+	if request.GetWorkId() != "" && (len(request.GetAuthorIds()) > 0 || len(request.GetContributors()) > 0) {
+		return errEditionAuthors
+	}
+
+	if request.GetIsbn() == "" {
+		return nil
+	}
+
+	isbn, err := normalizeISBN(request.GetIsbn())
+	if err != nil {
+		return err
+	}
+
+	request.Isbn = isbn
+	return nil
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"cmp"
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const addBooksChunkSize = 500
+
+func (i *implementation) AddBooks(server library.Library_AddBooksServer) error {
+	i.logger.Info("Receiving add books stream.")
+
+	results := make([]*library.AddBooksResult, 0)
+	chunk := make([]*library.AddBookRequest, 0, addBooksChunkSize)
+	indexes := make([]int32, 0, addBooksChunkSize)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		chunkResults, err := i.booksUseCase.AddBooks(server.Context(), chunk)
+
+		if err != nil {
+			i.logger.Error("Error during add books request.", zap.Error(err))
+			return err
+		}
+
+		for j, result := range chunkResults {
+			result.Index = indexes[j]
+			results = append(results, result)
+		}
+
+		chunk = chunk[:0]
+		indexes = indexes[:0]
+
+		return nil
+	}
+
+	for index := int32(0); ; index++ {
+		request, err := server.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			i.logger.Error("Error while receiving add books stream.", zap.Error(err))
+			return err
+		}
+
+		if err := validateAddBookRequest(request); err != nil {
+			i.logger.Error("Error during validating add books request.", zap.Error(err))
+			results = append(results, &library.AddBooksResult{
+				Index:        index,
+				ErrorCode:    int32(codes.InvalidArgument),
+				ErrorMessage: err.Error(),
+			})
+			continue
+		}
+
+		chunk = append(chunk, request)
+		indexes = append(indexes, index)
+
+		if len(chunk) == addBooksChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	slices.SortFunc(results, func(a, b *library.AddBooksResult) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
+
+	i.logger.Info("Add books request has passed successfully.")
+
+	return server.SendAndClose(&library.AddBooksResponse{
+		Results: results,
+	})
+}
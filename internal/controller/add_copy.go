@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) AddCopy(ctx context.Context, request *library.AddCopyRequest) (*library.AddCopyResponse, error) {
+	i.logger.Info("Validating add copy request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating add copy request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.copyUseCase.AddCopy(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during add copy request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Add copy request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) BatchGetBooks(ctx context.Context, request *library.BatchGetBooksRequest) (*library.BatchGetBooksResponse, error) {
+	i.logger.Info("Validating batch get books request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating batch get books request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.booksUseCase.BatchGetBooks(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during batch get books request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Batch get books request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) BlockPatron(ctx context.Context, request *library.BlockPatronRequest) (*library.BlockPatronResponse, error) {
+	i.logger.Info("Validating block patron request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating block patron request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.patronUseCase.BlockPatron(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during block patron request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Block patron request has passed successfully.")
+
+	return resp, nil
+}
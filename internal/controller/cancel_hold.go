@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CancelHold(ctx context.Context, request *library.CancelHoldRequest) (*library.CancelHoldResponse, error) {
+	i.logger.Info("Validating cancel hold request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating cancel hold request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.holdUseCase.CancelHold(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during cancel hold request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Cancel hold request has passed successfully.")
+
+	return resp, nil
+}
@@ -2,6 +2,9 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"slices"
 
 	"github.com/project/library/generated/api/library"
 	"go.uber.org/zap"
@@ -9,6 +12,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var (
+	authorNamePattern = regexp.MustCompile("^[A-Za-z0-9]+( [A-Za-z0-9]+)*$")
+
+	errInvalidAuthorName = errors.New("invalid ChangeAuthorInfoRequest.Name: value must be 1 to 512 bytes of " +
+		"space separated latin letters and digits")
+)
+
 func (i *implementation) ChangeAuthorInfo(ctx context.Context, request *library.ChangeAuthorInfoRequest) (*library.ChangeAuthorInfoResponse, error) {
 	i.logger.Info("Validating change author request.")
 
@@ -17,6 +27,15 @@ func (i *implementation) ChangeAuthorInfo(ctx context.Context, request *library.
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if err := validateChangedAuthorName(request); err != nil {
+		i.logger.Error("Error during validating change author info request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if request.GetEtag() == "" {
+		request.Etag = getIfMatch(ctx)
+	}
+
 	resp, err := i.authorUseCase.ChangeAuthorInfo(ctx, request)
 
 	if err != nil {
@@ -28,3 +47,18 @@ func (i *implementation) ChangeAuthorInfo(ctx context.Context, request *library.
 
 	return resp, nil
 }
+
+// validateChangedAuthorName checks the name only when it is going to be replaced, an empty mask replaces the name.
+func validateChangedAuthorName(request *library.ChangeAuthorInfoRequest) error {
+	paths := request.GetUpdateMask().GetPaths()
+	if len(paths) > 0 && !slices.Contains(paths, "name") {
+		return nil
+	}
+
+	name := request.GetName()
+	if len(name) == 0 || len(name) > 512 || !authorNamePattern.MatchString(name) {
+		return errInvalidAuthorName
+	}
+
+	return nil
+}
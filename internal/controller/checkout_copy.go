@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CheckoutCopy(ctx context.Context, request *library.CheckoutCopyRequest) (*library.CheckoutCopyResponse, error) {
+	i.logger.Info("Validating checkout copy request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating checkout copy request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.circulationUseCase.CheckoutCopy(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during checkout copy request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Checkout copy request has passed successfully.")
+
+	return resp, nil
+}
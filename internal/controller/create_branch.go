@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CreateBranch(ctx context.Context, request *library.CreateBranchRequest) (*library.CreateBranchResponse, error) {
+	i.logger.Info("Validating create branch request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating create branch request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.CreateBranch(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during create branch request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Create branch request has passed successfully.")
+
+	return resp, nil
+}
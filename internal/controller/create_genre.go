@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CreateGenre(ctx context.Context, request *library.CreateGenreRequest) (*library.CreateGenreResponse, error) {
+	i.logger.Info("Validating create genre request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating create genre request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.genreUseCase.CreateGenre(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during create genre request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Create genre request has passed successfully.")
+
+	return resp, nil
+}
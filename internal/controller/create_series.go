@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CreateSeries(ctx context.Context, request *library.CreateSeriesRequest) (*library.CreateSeriesResponse, error) {
+	i.logger.Info("Validating create series request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating create series request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.seriesUseCase.CreateSeries(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during create series request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Create series request has passed successfully.")
+
+	return resp, nil
+}
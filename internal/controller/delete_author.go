@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) DeleteAuthor(ctx context.Context, request *library.DeleteAuthorRequest) (*library.DeleteAuthorResponse, error) {
+	i.logger.Info("Validating delete author request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating delete author request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.authorUseCase.DeleteAuthor(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during delete author request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Delete author request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) DeleteBook(ctx context.Context, request *library.DeleteBookRequest) (*library.DeleteBookResponse, error) {
+	i.logger.Info("Validating delete book request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating delete book request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.booksUseCase.DeleteBook(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during delete book request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Delete book request has passed successfully.")
+
+	return resp, nil
+}
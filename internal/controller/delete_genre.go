@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) DeleteGenre(ctx context.Context, request *library.DeleteGenreRequest) (*library.DeleteGenreResponse, error) {
+	i.logger.Info("Validating delete genre request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating delete genre request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.genreUseCase.DeleteGenre(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during delete genre request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Delete genre request has passed successfully.")
+
+	return resp, nil
+}
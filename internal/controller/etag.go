@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ifMatchKey is the metadata key the gateway forwards the If-Match header under.
+const ifMatchKey = "if-match"
+
+func getIfMatch(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(ifMatchKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
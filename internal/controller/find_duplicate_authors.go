@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) FindDuplicateAuthors(ctx context.Context, request *library.FindDuplicateAuthorsRequest) (*library.FindDuplicateAuthorsResponse, error) {
+	i.logger.Info("Validating find duplicate authors request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating find duplicate authors request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.authorUseCase.FindDuplicateAuthors(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during find duplicate authors request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Find duplicate authors request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetBookByISBN(ctx context.Context, request *library.GetBookByISBNRequest) (*library.GetBookByISBNResponse, error) {
+	i.logger.Info("Validating get book by isbn request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get book by isbn request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	isbn, err := normalizeISBN(request.GetIsbn())
+
+	if err != nil {
+		i.logger.Error("Error during validating get book by isbn request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	request.Isbn = isbn
+
+	resp, err := i.booksUseCase.GetBookByISBN(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get book by isbn request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get book by isbn request has passed successfully.")
+
+	return resp, nil
+}
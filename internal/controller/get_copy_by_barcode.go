@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetCopyByBarcode(ctx context.Context, request *library.GetCopyByBarcodeRequest) (*library.GetCopyByBarcodeResponse, error) {
+	i.logger.Info("Validating get copy by barcode request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get copy by barcode request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.copyUseCase.GetCopyByBarcode(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get copy by barcode request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get copy by barcode request has passed successfully.")
+
+	return resp, nil
+}
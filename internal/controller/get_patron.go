@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetPatron(ctx context.Context, request *library.GetPatronRequest) (*library.GetPatronResponse, error) {
+	i.logger.Info("Validating get patron request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get patron request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.patronUseCase.GetPatron(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get patron request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get patron request has passed successfully.")
+
+	return resp, nil
+}
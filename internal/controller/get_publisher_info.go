@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetPublisherInfo(ctx context.Context, request *library.GetPublisherInfoRequest) (*library.GetPublisherInfoResponse, error) {
+	i.logger.Info("Validating get publisher info request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get publisher info request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.publisherUseCase.GetPublisherInfo(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get publisher info request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get publisher info request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetSeries(ctx context.Context, request *library.GetSeriesRequest) (*library.GetSeriesResponse, error) {
+	i.logger.Info("Validating get series request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get series request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.seriesUseCase.GetSeries(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get series request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get series request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetWork(ctx context.Context, request *library.GetWorkRequest) (*library.GetWorkResponse, error) {
+	i.logger.Info("Validating get work request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating get work request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.workUseCase.GetWork(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during get work request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Get work request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+)
+
+var errInvalidISBN = errors.New("invalid isbn")
+
+// normalizeISBN validates the checksum of an ISBN-10 or ISBN-13 and returns it as ISBN-13 digits.
+func normalizeISBN(raw string) (string, error) {
+	isbn := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(raw))
+
+	switch len(isbn) {
+	case 10:
+		if !isValidISBN10(isbn) {
+			return "", errInvalidISBN
+		}
+
+		return withISBN13CheckDigit("978" + isbn[:9]), nil
+	case 13:
+		if !isValidISBN13(isbn) {
+			return "", errInvalidISBN
+		}
+
+		return isbn, nil
+	default:
+		return "", errInvalidISBN
+	}
+}
+
+func isValidISBN10(isbn string) bool {
+	sum := 0
+
+	for i, c := range isbn {
+		var digit int
+
+		switch {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case c == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+
+		sum += (10 - i) * digit
+	}
+
+	return sum%11 == 0
+}
+
+func isValidISBN13(isbn string) bool {
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return false
+	}
+
+	for _, c := range isbn {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return withISBN13CheckDigit(isbn[:12]) == isbn
+}
+
+// withISBN13CheckDigit appends the check digit to the first 12 digits of an ISBN-13.
+func withISBN13CheckDigit(isbn string) string {
+	sum := 0
+
+	for i, c := range isbn {
+		digit := int(c - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return isbn + string(rune('0'+(10-sum%10)%10))
+}
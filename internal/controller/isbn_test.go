@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeISBN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		isbn     string
+		expected string
+		valid    bool
+	}{
+		{name: "ISBN-13", isbn: "9780306406157", expected: "9780306406157", valid: true},
+		{name: "ISBN-13 with hyphens", isbn: "978-0-306-40615-7", expected: "9780306406157", valid: true},
+		{name: "ISBN-10", isbn: "0306406152", expected: "9780306406157", valid: true},
+		{name: "ISBN-10 with X check digit", isbn: "0-8044-2957-x", expected: "9780804429573", valid: true},
+		{name: "ISBN-13 with 979 prefix", isbn: "979-10-90636-07-1", expected: "9791090636071", valid: true},
+		{name: "ISBN-13 with bad checksum", isbn: "9780306406158"},
+		{name: "ISBN-13 with unknown prefix", isbn: "9770306406157"},
+		{name: "ISBN-10 with bad checksum", isbn: "0306406153"},
+		{name: "ISBN-10 with X in the middle", isbn: "03064X6152"},
+		{name: "Wrong length", isbn: "978030640615"},
+		{name: "Letters", isbn: "978030640615A"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			isbn, err := normalizeISBN(tc.isbn)
+			if !tc.valid {
+				require.ErrorIs(t, err, errInvalidISBN)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, isbn)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListAuthors(ctx context.Context, request *library.ListAuthorsRequest) (*library.ListAuthorsResponse, error) {
+	i.logger.Info("Validating list authors request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list authors request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.authorUseCase.ListAuthors(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list authors request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List authors request has passed successfully.")
+
+	return resp, nil
+}
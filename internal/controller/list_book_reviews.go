@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListBookReviews(ctx context.Context, request *library.ListBookReviewsRequest) (*library.ListBookReviewsResponse, error) {
+	i.logger.Info("Validating list book reviews request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list book reviews request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.reviewUseCase.ListBookReviews(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list book reviews request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List book reviews request has passed successfully.")
+
+	return resp, nil
+}
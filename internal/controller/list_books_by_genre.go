@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListBooksByGenre(ctx context.Context, request *library.ListBooksByGenreRequest) (*library.ListBooksByGenreResponse, error) {
+	i.logger.Info("Validating list books by genre request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list books by genre request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.genreUseCase.ListBooksByGenre(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list books by genre request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List books by genre request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListBranches(ctx context.Context, request *library.ListBranchesRequest) (*library.ListBranchesResponse, error) {
+	i.logger.Info("Validating list branches request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list branches request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.ListBranches(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list branches request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List branches request has passed successfully.")
+
+	return resp, nil
+}
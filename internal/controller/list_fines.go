@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListFines(ctx context.Context, request *library.ListFinesRequest) (*library.ListFinesResponse, error) {
+	i.logger.Info("Validating list fines request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list fines request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.fineUseCase.ListFines(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list fines request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List fines request has passed successfully.")
+
+	return resp, nil
+}
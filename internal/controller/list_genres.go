@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListGenres(ctx context.Context, request *library.ListGenresRequest) (*library.ListGenresResponse, error) {
+	i.logger.Info("Validating list genres request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list genres request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.genreUseCase.ListGenres(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list genres request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List genres request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errHoldFilter = errors.New("book_id or patron_id is required")
+
+func (i *implementation) ListHolds(ctx context.Context, request *library.ListHoldsRequest) (*library.ListHoldsResponse, error) {
+	i.logger.Info("Validating list holds request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list holds request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if request.GetBookId() == "" && request.GetPatronId() == "" {
+		i.logger.Error("Error during validating list holds request.", zap.Error(errHoldFilter))
+		return nil, status.Error(codes.InvalidArgument, errHoldFilter.Error())
+	}
+
+	resp, err := i.holdUseCase.ListHolds(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list holds request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List holds request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListPublisherBooks(ctx context.Context, request *library.ListPublisherBooksRequest) (*library.ListPublisherBooksResponse, error) {
+	i.logger.Info("Validating list publisher books request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list publisher books request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.publisherUseCase.ListPublisherBooks(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list publisher books request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List publisher books request has passed successfully.")
+
+	return resp, nil
+}
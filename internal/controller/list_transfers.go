@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListTransfers(ctx context.Context, request *library.ListTransfersRequest) (*library.ListTransfersResponse, error) {
+	i.logger.Info("Validating list transfers request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating list transfers request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.ListTransfers(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during list transfers request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("List transfers request has passed successfully.")
+
+	return resp, nil
+}
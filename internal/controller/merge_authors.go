@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) MergeAuthors(ctx context.Context, request *library.MergeAuthorsRequest) (*library.MergeAuthorsResponse, error) {
+	i.logger.Info("Validating merge authors request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating merge authors request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.authorUseCase.MergeAuthors(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during merge authors request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Merge authors request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ModerateReview(ctx context.Context, request *library.ModerateReviewRequest) (*library.ModerateReviewResponse, error) {
+	i.logger.Info("Validating moderate review request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating moderate review request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.reviewUseCase.ModerateReview(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during moderate review request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Moderate review request has passed successfully.")
+
+	return resp, nil
+}
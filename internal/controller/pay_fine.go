@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) PayFine(ctx context.Context, request *library.PayFineRequest) (*library.PayFineResponse, error) {
+	i.logger.Info("Validating pay fine request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating pay fine request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.fineUseCase.PayFine(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during pay fine request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Pay fine request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) PlaceHold(ctx context.Context, request *library.PlaceHoldRequest) (*library.PlaceHoldResponse, error) {
+	i.logger.Info("Validating place hold request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating place hold request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.holdUseCase.PlaceHold(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during place hold request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Place hold request has passed successfully.")
+
+	return resp, nil
+}
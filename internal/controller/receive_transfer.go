@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ReceiveTransfer(ctx context.Context, request *library.ReceiveTransferRequest) (*library.ReceiveTransferResponse, error) {
+	i.logger.Info("Validating receive transfer request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating receive transfer request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.ReceiveTransfer(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during receive transfer request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Receive transfer request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errPatronContact = errors.New("email or phone is required")
+
+func (i *implementation) RegisterPatron(ctx context.Context, request *library.RegisterPatronRequest) (*library.RegisterPatronResponse, error) {
+	i.logger.Info("Validating register patron request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating register patron request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if request.GetEmail() == "" && request.GetPhone() == "" {
+		i.logger.Error("Error during validating register patron request.", zap.Error(errPatronContact))
+		return nil, status.Error(codes.InvalidArgument, errPatronContact.Error())
+	}
+
+	resp, err := i.patronUseCase.RegisterPatron(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during register patron request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Register patron request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RegisterPublisher(ctx context.Context, request *library.RegisterPublisherRequest) (*library.RegisterPublisherResponse, error) {
+	i.logger.Info("Validating register publisher request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating register publisher request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.publisherUseCase.RegisterPublisher(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during register publisher request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Register publisher request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RemoveBookFromSeries(ctx context.Context, request *library.RemoveBookFromSeriesRequest) (*library.RemoveBookFromSeriesResponse, error) {
+	i.logger.Info("Validating remove book from series request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating remove book from series request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.seriesUseCase.RemoveBookFromSeries(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during remove book from series request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Remove book from series request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RenewLoan(ctx context.Context, request *library.RenewLoanRequest) (*library.RenewLoanResponse, error) {
+	i.logger.Info("Validating renew loan request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating renew loan request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.circulationUseCase.RenewLoan(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during renew loan request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Renew loan request has passed successfully.")
+
+	return resp, nil
+}
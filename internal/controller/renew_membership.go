@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RenewMembership(ctx context.Context, request *library.RenewMembershipRequest) (*library.RenewMembershipResponse, error) {
+	i.logger.Info("Validating renew membership request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating renew membership request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.patronUseCase.RenewMembership(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during renew membership request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Renew membership request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDuplicateSeriesBook = errors.New("each book can be listed only once")
+
+func (i *implementation) ReorderSeries(ctx context.Context, request *library.ReorderSeriesRequest) (*library.ReorderSeriesResponse, error) {
+	i.logger.Info("Validating reorder series request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating reorder series request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	seen := make(map[string]struct{}, len(request.GetVolumes()))
+	for _, volume := range request.GetVolumes() {
+		if _, ok := seen[volume.GetBookId()]; ok {
+			i.logger.Error("Error during validating reorder series request.", zap.Error(errDuplicateSeriesBook))
+			return nil, status.Error(codes.InvalidArgument, errDuplicateSeriesBook.Error())
+		}
+		seen[volume.GetBookId()] = struct{}{}
+	}
+
+	resp, err := i.seriesUseCase.ReorderSeries(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during reorder series request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Reorder series request has passed successfully.")
+
+	return resp, nil
+}
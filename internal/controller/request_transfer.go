@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RequestTransfer(ctx context.Context, request *library.RequestTransferRequest) (*library.RequestTransferResponse, error) {
+	i.logger.Info("Validating request transfer request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating request transfer request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.RequestTransfer(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during request transfer request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Request transfer request has passed successfully.")
+
+	return resp, nil
+}
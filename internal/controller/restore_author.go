@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RestoreAuthor(ctx context.Context, request *library.RestoreAuthorRequest) (*library.RestoreAuthorResponse, error) {
+	i.logger.Info("Validating restore author request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating restore author request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.authorUseCase.RestoreAuthor(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during restore author request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Restore author request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RestoreBook(ctx context.Context, request *library.RestoreBookRequest) (*library.RestoreBookResponse, error) {
+	i.logger.Info("Validating restore book request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating restore book request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.booksUseCase.RestoreBook(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during restore book request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Restore book request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RetireCopy(ctx context.Context, request *library.RetireCopyRequest) (*library.RetireCopyResponse, error) {
+	i.logger.Info("Validating retire copy request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating retire copy request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.copyUseCase.RetireCopy(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during retire copy request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Retire copy request has passed successfully.")
+
+	return resp, nil
+}
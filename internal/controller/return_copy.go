@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ReturnCopy(ctx context.Context, request *library.ReturnCopyRequest) (*library.ReturnCopyResponse, error) {
+	i.logger.Info("Validating return copy request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating return copy request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.circulationUseCase.ReturnCopy(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during return copy request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Return copy request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) SearchCatalog(ctx context.Context, request *library.SearchCatalogRequest) (*library.SearchCatalogResponse, error) {
+	i.logger.Info("Validating search catalog request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating search catalog request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.booksUseCase.SearchCatalog(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during search catalog request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Search catalog request has passed successfully.")
+
+	return resp, nil
+}
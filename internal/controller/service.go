@@ -1,6 +1,6 @@
 package controller
 
-//go:generate ../../bin/mockgen --build_flags=--mod=mod -destination=../../generated/mocks/server_mock.go -package=mocks . GetAuthorBooksServer
+//go:generate ../../bin/mockgen --build_flags=--mod=mod -destination=../../generated/mocks/server_mock.go -package=mocks . GetAuthorBooksServer,AddBooksServer,StreamChangesServer
 
 import (
 	generated "github.com/project/library/generated/api/library"
@@ -12,22 +12,66 @@ type GetAuthorBooksServer interface {
 	generated.Library_GetAuthorBooksServer
 }
 
+type AddBooksServer interface {
+	generated.Library_AddBooksServer
+}
+
+type StreamChangesServer interface {
+	generated.Library_StreamChangesServer
+}
+
 var _ generated.LibraryServer = (*implementation)(nil)
 
 type implementation struct {
-	logger        *zap.Logger
-	booksUseCase  library.BooksUseCase
-	authorUseCase library.AuthorUseCase
+	logger             *zap.Logger
+	booksUseCase       library.BooksUseCase
+	authorUseCase      library.AuthorUseCase
+	changesUseCase     library.ChangesUseCase
+	publisherUseCase   library.PublisherUseCase
+	genreUseCase       library.GenreUseCase
+	seriesUseCase      library.SeriesUseCase
+	workUseCase        library.WorkUseCase
+	copyUseCase        library.CopyUseCase
+	patronUseCase      library.PatronUseCase
+	circulationUseCase library.CirculationUseCase
+	holdUseCase        library.HoldUseCase
+	fineUseCase        library.FineUseCase
+	branchUseCase      library.BranchUseCase
+	reviewUseCase      library.ReviewUseCase
 }
 
 func New(
 	logger *zap.Logger,
 	booksUseCase library.BooksUseCase,
 	authorUseCase library.AuthorUseCase,
+	changesUseCase library.ChangesUseCase,
+	publisherUseCase library.PublisherUseCase,
+	genreUseCase library.GenreUseCase,
+	seriesUseCase library.SeriesUseCase,
+	workUseCase library.WorkUseCase,
+	copyUseCase library.CopyUseCase,
+	patronUseCase library.PatronUseCase,
+	circulationUseCase library.CirculationUseCase,
+	holdUseCase library.HoldUseCase,
+	fineUseCase library.FineUseCase,
+	branchUseCase library.BranchUseCase,
+	reviewUseCase library.ReviewUseCase,
 ) *implementation {
 	return &implementation{
-		logger:        logger,
-		booksUseCase:  booksUseCase,
-		authorUseCase: authorUseCase,
+		logger:             logger,
+		booksUseCase:       booksUseCase,
+		authorUseCase:      authorUseCase,
+		changesUseCase:     changesUseCase,
+		publisherUseCase:   publisherUseCase,
+		genreUseCase:       genreUseCase,
+		seriesUseCase:      seriesUseCase,
+		workUseCase:        workUseCase,
+		copyUseCase:        copyUseCase,
+		patronUseCase:      patronUseCase,
+		circulationUseCase: circulationUseCase,
+		holdUseCase:        holdUseCase,
+		fineUseCase:        fineUseCase,
+		branchUseCase:      branchUseCase,
+		reviewUseCase:      reviewUseCase,
 	}
 }
@@ -1,10 +1,12 @@
 package controller
 
 import (
+	"io"
 	"testing"
 	"time"
 
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/google/uuid"
@@ -14,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -50,6 +53,63 @@ func TestAddBook(t *testing.T) {
 			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
 			expectedError:    status.Error(codes.InvalidArgument, "test"),
 		},
+		{
+			name: "ISBN validation error",
+			request: &library.AddBookRequest{
+				Name: "test",
+				Isbn: "0-306-40615-3",
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Language validation error",
+			request: &library.AddBookRequest{
+				Name:     "test",
+				Language: "english language",
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Edition authors validation error",
+			request: &library.AddBookRequest{
+				Name:      "test",
+				AuthorIds: []string{uuid.NewString()},
+				WorkId:    uuid.NewString(),
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Edition contributors validation error",
+			request: &library.AddBookRequest{
+				Name:         "test",
+				Contributors: []*library.Contributor{{AuthorId: uuid.NewString()}},
+				WorkId:       uuid.NewString(),
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Authors and contributors validation error",
+			request: &library.AddBookRequest{
+				Name:         "test",
+				AuthorIds:    []string{uuid.NewString()},
+				Contributors: []*library.Contributor{{AuthorId: uuid.NewString()}},
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Contributor role validation error",
+			request: &library.AddBookRequest{
+				Name:         "test",
+				Contributors: []*library.Contributor{{AuthorId: uuid.NewString(), Role: 42}},
+			},
+			expectedResponse: &library.AddBookResponse{Book: &library.Book{}},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
 		{
 			name:             "Internal error",
 			request:          &library.AddBookRequest{},
@@ -69,7 +129,11 @@ func TestAddBook(t *testing.T) {
 
 			logger := zap.NewNop()
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
 			response, err := service.AddBook(ctx, tc.request)
@@ -88,6 +152,103 @@ func TestAddBook(t *testing.T) {
 	}
 }
 
+func TestAddBooks(t *testing.T) {
+	t.Parallel()
+
+	valid := &library.AddBookRequest{Name: "Valid", AuthorIds: []string{uuid.NewString()}}
+	invalid := &library.AddBookRequest{Name: "Invalid", AuthorIds: []string{"1"}}
+
+	testCases := []struct {
+		name          string
+		requests      []*library.AddBookRequest
+		recvError     error
+		useCaseError  error
+		expectedCodes []codes.Code
+		expectedError error
+	}{
+		{
+			name:          "No error",
+			requests:      []*library.AddBookRequest{valid, invalid, valid},
+			expectedCodes: []codes.Code{codes.OK, codes.InvalidArgument, codes.OK},
+		},
+		{
+			name:          "Receive error",
+			requests:      []*library.AddBookRequest{valid},
+			recvError:     status.Error(codes.Canceled, "test"),
+			expectedError: status.Error(codes.Canceled, "test"),
+		},
+		{
+			name:          "Internal error",
+			requests:      []*library.AddBookRequest{valid, invalid},
+			useCaseError:  status.Error(codes.Internal, "test"),
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			server := mocks.NewMockAddBooksServer(ctrl)
+			server.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+			calls := make([]any, 0, len(tc.requests)+1)
+			for _, request := range tc.requests {
+				calls = append(calls, server.EXPECT().Recv().Return(request, nil))
+			}
+
+			if tc.recvError != nil {
+				calls = append(calls, server.EXPECT().Recv().Return(nil, tc.recvError))
+			} else {
+				calls = append(calls, server.EXPECT().Recv().Return(nil, io.EOF))
+			}
+			gomock.InOrder(calls...)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().AddBooks(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, requests []*library.AddBookRequest) ([]*library.AddBooksResult, error) {
+					results := make([]*library.AddBooksResult, len(requests))
+					for i, request := range requests {
+						results[i] = &library.AddBooksResult{Book: &library.Book{Name: request.GetName()}}
+					}
+					return results, tc.useCaseError
+				},
+			).MaxTimes(1)
+
+			var response *library.AddBooksResponse
+			server.EXPECT().SendAndClose(gomock.Any()).DoAndReturn(func(r *library.AddBooksResponse) error {
+				response = r
+				return nil
+			}).MaxTimes(1)
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			err := service.AddBooks(server)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if tc.expectedError != nil {
+				require.Equal(t, expS.Code(), s.Code())
+				return
+			}
+
+			require.Len(t, response.GetResults(), len(tc.expectedCodes))
+			for i, result := range response.GetResults() {
+				require.Equal(t, int32(i), result.GetIndex())
+				require.Equal(t, tc.expectedCodes[i], codes.Code(result.GetErrorCode()))
+			}
+		})
+	}
+}
+
 func TestChangeAuthorInfo(t *testing.T) {
 	t.Parallel()
 
@@ -124,6 +285,34 @@ func TestChangeAuthorInfo(t *testing.T) {
 			expectedResponse: &library.ChangeAuthorInfoResponse{},
 			expectedError:    status.Error(codes.InvalidArgument, "test"),
 		},
+		{
+			name: "Empty name with empty mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id: uuid.NewString(),
+			},
+			expectedResponse: &library.ChangeAuthorInfoResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Name validation error with name in mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				Name:       "\\+*",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"biography", "name"}},
+			},
+			expectedResponse: &library.ChangeAuthorInfoResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Empty name outside of mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				Biography:  "test",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"biography"}},
+			},
+			expectedResponse: &library.ChangeAuthorInfoResponse{},
+			expectedError:    nil,
+		},
 		{
 			name: "Internal error",
 			request: &library.ChangeAuthorInfoRequest{
@@ -146,7 +335,11 @@ func TestChangeAuthorInfo(t *testing.T) {
 
 			logger := zap.NewNop()
 			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
 			response, err := service.ChangeAuthorInfo(ctx, tc.request)
@@ -210,7 +403,11 @@ func TestGetAuthorBooks(t *testing.T) {
 
 			logger := zap.NewNop()
 			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			err := service.GetAuthorBooks(tc.request, server)
 
@@ -279,7 +476,11 @@ func TestGetAuthorInfo(t *testing.T) {
 
 			logger := zap.NewNop()
 			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
 			response, err := service.GetAuthorInfo(ctx, tc.request)
@@ -350,7 +551,11 @@ func TestGetBookInfo(t *testing.T) {
 
 			logger := zap.NewNop()
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
 			response, err := service.GetBookInfo(ctx, tc.request)
@@ -398,6 +603,28 @@ func TestRegisterAuthor(t *testing.T) {
 			},
 			expectedError: status.Error(codes.InvalidArgument, "test"),
 		},
+		{
+			name: "Nationality validation error",
+			request: &library.RegisterAuthorRequest{
+				Name:        "test",
+				Nationality: "usa",
+			},
+			expectedResponse: &library.RegisterAuthorResponse{
+				Id: uuid.NewString(),
+			},
+			expectedError: status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Alias validation error",
+			request: &library.RegisterAuthorRequest{
+				Name:    "Mark Twain",
+				Aliases: []string{"Samuel Clemens", ""},
+			},
+			expectedResponse: &library.RegisterAuthorResponse{
+				Id: uuid.NewString(),
+			},
+			expectedError: status.Error(codes.InvalidArgument, "test"),
+		},
 		{
 			name: "Internal error",
 			request: &library.RegisterAuthorRequest{
@@ -421,7 +648,11 @@ func TestRegisterAuthor(t *testing.T) {
 
 			logger := zap.NewNop()
 			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
 			response, err := service.RegisterAuthor(ctx, tc.request)
@@ -446,6 +677,8 @@ func TestUpdateBook(t *testing.T) {
 	testCases := []struct {
 		name             string
 		request          *library.UpdateBookRequest
+		ifMatch          string
+		expectedEtag     string
 		expectedResponse *library.UpdateBookResponse
 		expectedError    error
 	}{
@@ -459,6 +692,29 @@ func TestUpdateBook(t *testing.T) {
 			expectedResponse: &library.UpdateBookResponse{},
 			expectedError:    nil,
 		},
+		{
+			name: "Etag from If-Match",
+			request: &library.UpdateBookRequest{
+				Id:   uuid.NewString(),
+				Name: "test",
+			},
+			ifMatch:          `"4"`,
+			expectedEtag:     `"4"`,
+			expectedResponse: &library.UpdateBookResponse{},
+			expectedError:    nil,
+		},
+		{
+			name: "Etag in request overrides If-Match",
+			request: &library.UpdateBookRequest{
+				Id:   uuid.NewString(),
+				Name: "test",
+				Etag: "5",
+			},
+			ifMatch:          `"4"`,
+			expectedEtag:     "5",
+			expectedResponse: &library.UpdateBookResponse{},
+			expectedError:    nil,
+		},
 		{
 			name: "Book id validation error",
 			request: &library.UpdateBookRequest{
@@ -479,6 +735,16 @@ func TestUpdateBook(t *testing.T) {
 			expectedResponse: &library.UpdateBookResponse{},
 			expectedError:    status.Error(codes.InvalidArgument, "test"),
 		},
+		{
+			name: "Authors and contributors validation error",
+			request: &library.UpdateBookRequest{
+				Id:           uuid.NewString(),
+				AuthorIds:    []string{uuid.NewString()},
+				Contributors: []*library.Contributor{{AuthorId: uuid.NewString()}},
+			},
+			expectedResponse: &library.UpdateBookResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
 		{
 			name: "Internal error",
 			request: &library.UpdateBookRequest{
@@ -501,11 +767,3446 @@ func TestUpdateBook(t *testing.T) {
 
 			logger := zap.NewNop()
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
-			service := New(logger, booksUseCase, authorUseCase)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
 
 			ctx := context.Background()
+			if tc.ifMatch != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("if-match", tc.ifMatch))
+			}
+
 			response, err := service.UpdateBook(ctx, tc.request)
 
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+				require.Equal(t, tc.expectedEtag, tc.request.GetEtag())
+			}
+		})
+	}
+}
+
+func TestDeleteBook(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.DeleteBookRequest
+		expectedResponse *library.DeleteBookResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.DeleteBookRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteBookResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.DeleteBookRequest{Id: "1"},
+			expectedResponse: &library.DeleteBookResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.DeleteBookRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteBookResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().DeleteBook(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.DeleteBook(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRestoreBook(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RestoreBookRequest
+		expectedResponse *library.RestoreBookResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RestoreBookRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RestoreBookResponse{Book: &library.Book{Id: uuid.NewString(), Name: "test"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.RestoreBookRequest{Id: "1"},
+			expectedResponse: &library.RestoreBookResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RestoreBookRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RestoreBookResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().RestoreBook(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RestoreBook(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestDeleteAuthor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.DeleteAuthorRequest
+		expectedResponse *library.DeleteAuthorResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.DeleteAuthorRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteAuthorResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.DeleteAuthorRequest{Id: "1"},
+			expectedResponse: &library.DeleteAuthorResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.DeleteAuthorRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteAuthorResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().DeleteAuthor(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.DeleteAuthor(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRestoreAuthor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RestoreAuthorRequest
+		expectedResponse *library.RestoreAuthorResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RestoreAuthorRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RestoreAuthorResponse{Id: uuid.NewString(), Name: "test"},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.RestoreAuthorRequest{Id: "1"},
+			expectedResponse: &library.RestoreAuthorResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RestoreAuthorRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RestoreAuthorResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().RestoreAuthor(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RestoreAuthor(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListBooks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListBooksRequest
+		expectedResponse *library.ListBooksResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListBooksRequest{PageSize: 10, AuthorId: uuid.NewString()},
+			expectedResponse: &library.ListBooksResponse{Books: []*library.Book{{Id: uuid.NewString(), Name: "test"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Author id validation error",
+			request:          &library.ListBooksRequest{AuthorId: "1"},
+			expectedResponse: &library.ListBooksResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListBooksRequest{PageSize: 10, AuthorId: uuid.NewString()},
+			expectedResponse: &library.ListBooksResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().ListBooks(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListBooks(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListAuthors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListAuthorsRequest
+		expectedResponse *library.ListAuthorsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListAuthorsRequest{Name: "test", NameMatch: library.AuthorNameMatch_AUTHOR_NAME_MATCH_PREFIX},
+			expectedResponse: &library.ListAuthorsResponse{Authors: []*library.Author{{Id: uuid.NewString(), Name: "test"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Page size validation error",
+			request:          &library.ListAuthorsRequest{PageSize: 100000},
+			expectedResponse: &library.ListAuthorsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListAuthorsRequest{Name: "test", NameMatch: library.AuthorNameMatch_AUTHOR_NAME_MATCH_PREFIX},
+			expectedResponse: &library.ListAuthorsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().ListAuthors(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListAuthors(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestSearchCatalog(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.SearchCatalogRequest
+		expectedResponse *library.SearchCatalogResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.SearchCatalogRequest{Query: "war", Language: "en"},
+			expectedResponse: &library.SearchCatalogResponse{Results: []*library.SearchCatalogResult{{Id: uuid.NewString(), Name: "War"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Language validation error",
+			request:          &library.SearchCatalogRequest{Query: "war", Language: "de"},
+			expectedResponse: &library.SearchCatalogResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.SearchCatalogRequest{Query: "war", Language: "en"},
+			expectedResponse: &library.SearchCatalogResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().SearchCatalog(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.SearchCatalog(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestBatchGetBooks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.BatchGetBooksRequest
+		expectedResponse *library.BatchGetBooksResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.BatchGetBooksRequest{Ids: []string{uuid.NewString()}},
+			expectedResponse: &library.BatchGetBooksResponse{MissingIds: []string{uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Empty ids validation error",
+			request:          &library.BatchGetBooksRequest{},
+			expectedResponse: &library.BatchGetBooksResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.BatchGetBooksRequest{Ids: []string{uuid.NewString()}},
+			expectedResponse: &library.BatchGetBooksResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().BatchGetBooks(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.BatchGetBooks(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestBatchGetAuthors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.BatchGetAuthorsRequest
+		expectedResponse *library.BatchGetAuthorsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.BatchGetAuthorsRequest{Ids: []string{uuid.NewString()}},
+			expectedResponse: &library.BatchGetAuthorsResponse{Authors: []*library.Author{{Id: uuid.NewString(), Name: "Test"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.BatchGetAuthorsRequest{Ids: []string{"1"}},
+			expectedResponse: &library.BatchGetAuthorsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.BatchGetAuthorsRequest{Ids: []string{uuid.NewString()}},
+			expectedResponse: &library.BatchGetAuthorsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().BatchGetAuthors(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.BatchGetAuthors(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestStreamChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		request       *library.StreamChangesRequest
+		expectedError error
+	}{
+		{
+			name: "No error",
+			request: &library.StreamChangesRequest{
+				FromSequence: 10,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Sequence validation error",
+			request: &library.StreamChangesRequest{
+				FromSequence: -1,
+			},
+			expectedError: status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Internal error",
+			request: &library.StreamChangesRequest{
+				FromSequence: 10,
+			},
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			server := mocks.NewMockStreamChangesServer(ctrl)
+			server.EXPECT().Context().Return(context.Background()).AnyTimes()
+
+			changesUseCase := mocks.NewMockChangesUseCase(ctrl)
+			changesUseCase.EXPECT().StreamChanges(gomock.Any(), tc.request, server).
+				Return(tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, changesUseCase,
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			err := service.StreamChanges(tc.request, server)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, s.Code(), expS.Code())
+			}
+		})
+	}
+}
+
+func TestGetBookByISBN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetBookByISBNRequest
+		expectedResponse *library.GetBookByISBNResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetBookByISBNRequest{Isbn: "0-306-40615-2"},
+			expectedResponse: &library.GetBookByISBNResponse{Book: &library.Book{Id: uuid.NewString(), Name: "test", Isbn: "9780306406157"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Checksum validation error",
+			request:          &library.GetBookByISBNRequest{Isbn: "978-0-306-40615-8"},
+			expectedResponse: &library.GetBookByISBNResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetBookByISBNRequest{Isbn: "0-306-40615-2"},
+			expectedResponse: &library.GetBookByISBNResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			booksUseCase.EXPECT().GetBookByISBN(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl),
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetBookByISBN(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRegisterPublisher(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RegisterPublisherRequest
+		expectedResponse *library.RegisterPublisherResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RegisterPublisherRequest{Name: "test", Country: "GB", Website: "https://example.com"},
+			expectedResponse: &library.RegisterPublisherResponse{Publisher: &library.Publisher{Id: uuid.NewString(), Name: "test"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Country validation error",
+			request:          &library.RegisterPublisherRequest{Name: "test", Country: "gbr"},
+			expectedResponse: &library.RegisterPublisherResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RegisterPublisherRequest{Name: "test", Country: "GB", Website: "https://example.com"},
+			expectedResponse: &library.RegisterPublisherResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			publisherUseCase.EXPECT().RegisterPublisher(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				publisherUseCase, mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RegisterPublisher(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetPublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetPublisherInfoRequest
+		expectedResponse *library.GetPublisherInfoResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetPublisherInfoRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetPublisherInfoResponse{Publisher: &library.Publisher{Id: uuid.NewString(), Name: "test"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.GetPublisherInfoRequest{Id: "12"},
+			expectedResponse: &library.GetPublisherInfoResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetPublisherInfoRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetPublisherInfoResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			publisherUseCase.EXPECT().GetPublisherInfo(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				publisherUseCase, mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetPublisherInfo(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListPublisherBooks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListPublisherBooksRequest
+		expectedResponse *library.ListPublisherBooksResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListPublisherBooksRequest{PublisherId: uuid.NewString(), PageSize: 10},
+			expectedResponse: &library.ListPublisherBooksResponse{Books: []*library.Book{{Id: uuid.NewString(), Name: "test"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Page size validation error",
+			request:          &library.ListPublisherBooksRequest{PublisherId: uuid.NewString(), PageSize: 1001},
+			expectedResponse: &library.ListPublisherBooksResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListPublisherBooksRequest{PublisherId: uuid.NewString(), PageSize: 10},
+			expectedResponse: &library.ListPublisherBooksResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			publisherUseCase.EXPECT().ListPublisherBooks(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				publisherUseCase, mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListPublisherBooks(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestCreateGenre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.CreateGenreRequest
+		expectedResponse *library.CreateGenreResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.CreateGenreRequest{Name: "Fantasy"},
+			expectedResponse: &library.CreateGenreResponse{Genre: &library.Genre{Id: uuid.NewString(), Name: "Fantasy"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Name validation error",
+			request:          &library.CreateGenreRequest{},
+			expectedResponse: &library.CreateGenreResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.CreateGenreRequest{Name: "Fantasy"},
+			expectedResponse: &library.CreateGenreResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			genreUseCase.EXPECT().CreateGenre(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), genreUseCase, mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.CreateGenre(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestUpdateGenre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.UpdateGenreRequest
+		expectedResponse *library.UpdateGenreResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.UpdateGenreRequest{Id: uuid.NewString(), Name: "Fantasy"},
+			expectedResponse: &library.UpdateGenreResponse{Genre: &library.Genre{Id: uuid.NewString(), Name: "Fantasy"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.UpdateGenreRequest{Id: "1", Name: "Fantasy"},
+			expectedResponse: &library.UpdateGenreResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.UpdateGenreRequest{Id: uuid.NewString(), Name: "Fantasy"},
+			expectedResponse: &library.UpdateGenreResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			genreUseCase.EXPECT().UpdateGenre(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), genreUseCase, mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.UpdateGenre(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestDeleteGenre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.DeleteGenreRequest
+		expectedResponse *library.DeleteGenreResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.DeleteGenreRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteGenreResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.DeleteGenreRequest{Id: "1"},
+			expectedResponse: &library.DeleteGenreResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.DeleteGenreRequest{Id: uuid.NewString()},
+			expectedResponse: &library.DeleteGenreResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			genreUseCase.EXPECT().DeleteGenre(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), genreUseCase, mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.DeleteGenre(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListGenres(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListGenresRequest
+		expectedResponse *library.ListGenresResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListGenresRequest{},
+			expectedResponse: &library.ListGenresResponse{Genres: []*library.Genre{&library.Genre{Id: uuid.NewString(), Name: "Fantasy"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Root id validation error",
+			request:          &library.ListGenresRequest{RootId: "1"},
+			expectedResponse: &library.ListGenresResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListGenresRequest{},
+			expectedResponse: &library.ListGenresResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			genreUseCase.EXPECT().ListGenres(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), genreUseCase, mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListGenres(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListBooksByGenre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListBooksByGenreRequest
+		expectedResponse *library.ListBooksByGenreResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListBooksByGenreRequest{GenreId: uuid.NewString(), IncludeSubgenres: true},
+			expectedResponse: &library.ListBooksByGenreResponse{Books: []*library.Book{}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Genre id validation error",
+			request:          &library.ListBooksByGenreRequest{GenreId: "1"},
+			expectedResponse: &library.ListBooksByGenreResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListBooksByGenreRequest{GenreId: uuid.NewString(), IncludeSubgenres: true},
+			expectedResponse: &library.ListBooksByGenreResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			genreUseCase.EXPECT().ListBooksByGenre(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), genreUseCase, mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListBooksByGenre(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestCreateSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.CreateSeriesRequest
+		expectedResponse *library.CreateSeriesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.CreateSeriesRequest{Name: "Discworld"},
+			expectedResponse: &library.CreateSeriesResponse{Series: &library.Series{Id: uuid.NewString(), Name: "Discworld"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Name validation error",
+			request:          &library.CreateSeriesRequest{},
+			expectedResponse: &library.CreateSeriesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.CreateSeriesRequest{Name: "Discworld"},
+			expectedResponse: &library.CreateSeriesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			seriesUseCase.EXPECT().CreateSeries(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), seriesUseCase, mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.CreateSeries(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetSeriesRequest
+		expectedResponse *library.GetSeriesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetSeriesRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetSeriesResponse{Series: &library.Series{Id: uuid.NewString(), Name: "Discworld"}, Volumes: []*library.SeriesVolume{}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.GetSeriesRequest{Id: "1"},
+			expectedResponse: &library.GetSeriesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetSeriesRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetSeriesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			seriesUseCase.EXPECT().GetSeries(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), seriesUseCase, mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetSeries(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestSetBookSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.SetBookSeriesRequest
+		expectedResponse *library.SetBookSeriesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.SetBookSeriesRequest{BookId: uuid.NewString(), SeriesId: uuid.NewString(), Volume: 2.5},
+			expectedResponse: &library.SetBookSeriesResponse{Series: &library.BookSeries{SeriesId: uuid.NewString(), Volume: 2.5}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Volume validation error",
+			request:          &library.SetBookSeriesRequest{BookId: uuid.NewString(), SeriesId: uuid.NewString()},
+			expectedResponse: &library.SetBookSeriesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.SetBookSeriesRequest{BookId: uuid.NewString(), SeriesId: uuid.NewString(), Volume: 2.5},
+			expectedResponse: &library.SetBookSeriesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			seriesUseCase.EXPECT().SetBookSeries(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), seriesUseCase, mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.SetBookSeries(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRemoveBookFromSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RemoveBookFromSeriesRequest
+		expectedResponse *library.RemoveBookFromSeriesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RemoveBookFromSeriesRequest{BookId: uuid.NewString()},
+			expectedResponse: &library.RemoveBookFromSeriesResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Book id validation error",
+			request:          &library.RemoveBookFromSeriesRequest{BookId: "1"},
+			expectedResponse: &library.RemoveBookFromSeriesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RemoveBookFromSeriesRequest{BookId: uuid.NewString()},
+			expectedResponse: &library.RemoveBookFromSeriesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			seriesUseCase.EXPECT().RemoveBookFromSeries(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), seriesUseCase, mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RemoveBookFromSeries(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestReorderSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ReorderSeriesRequest
+		expectedResponse *library.ReorderSeriesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ReorderSeriesRequest{SeriesId: uuid.NewString(), Volumes: []*library.ReorderSeriesRequest_Volume{{BookId: uuid.NewString(), Volume: 1}}},
+			expectedResponse: &library.ReorderSeriesResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Duplicate book validation error",
+			request:          &library.ReorderSeriesRequest{SeriesId: uuid.NewString(), Volumes: []*library.ReorderSeriesRequest_Volume{{BookId: "4b8f2f43-5c43-4b1b-9a53-0b5c7d3d6a10", Volume: 1}, {BookId: "4b8f2f43-5c43-4b1b-9a53-0b5c7d3d6a10", Volume: 2}}},
+			expectedResponse: &library.ReorderSeriesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ReorderSeriesRequest{SeriesId: uuid.NewString(), Volumes: []*library.ReorderSeriesRequest_Volume{{BookId: uuid.NewString(), Volume: 1}}},
+			expectedResponse: &library.ReorderSeriesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			seriesUseCase.EXPECT().ReorderSeries(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), seriesUseCase, mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl),
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ReorderSeries(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetWork(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetWorkRequest
+		expectedResponse *library.GetWorkResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetWorkRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetWorkResponse{Work: &library.Work{Id: uuid.NewString(), Name: "War and Peace"}, Editions: []*library.Book{}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.GetWorkRequest{Id: "1"},
+			expectedResponse: &library.GetWorkResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetWorkRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetWorkResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			workUseCase := mocks.NewMockWorkUseCase(ctrl)
+			workUseCase.EXPECT().GetWork(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl),
+				mocks.NewMockSeriesUseCase(ctrl), workUseCase, mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetWork(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestAddCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.AddCopyRequest
+		expectedResponse *library.AddCopyResponse
+		expectedError    error
+	}{
+		{
+			name: "No error",
+			request: &library.AddCopyRequest{BookId: uuid.NewString(), Barcode: "LIB-0001", PriceCents: 1999,
+				BranchId: uuid.NewString()},
+			expectedResponse: &library.AddCopyResponse{Copy: &library.Copy{Id: uuid.NewString(), Barcode: "LIB-0001"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "No error without branch",
+			request:          &library.AddCopyRequest{BookId: uuid.NewString(), Barcode: "LIB-0002"},
+			expectedResponse: &library.AddCopyResponse{Copy: &library.Copy{Id: uuid.NewString(), Barcode: "LIB-0002"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Branch validation error",
+			request:          &library.AddCopyRequest{BookId: uuid.NewString(), Barcode: "LIB-0001", BranchId: "1"},
+			expectedResponse: &library.AddCopyResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Barcode validation error",
+			request:          &library.AddCopyRequest{BookId: uuid.NewString(), Barcode: "LIB 0001"},
+			expectedResponse: &library.AddCopyResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Internal error",
+			request: &library.AddCopyRequest{BookId: uuid.NewString(), Barcode: "LIB-0001", PriceCents: 1999,
+				BranchId: uuid.NewString()},
+			expectedResponse: &library.AddCopyResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			copyUseCase.EXPECT().AddCopy(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), copyUseCase, mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.AddCopy(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetCopyByBarcode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetCopyByBarcodeRequest
+		expectedResponse *library.GetCopyByBarcodeResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetCopyByBarcodeRequest{Barcode: "LIB-0001"},
+			expectedResponse: &library.GetCopyByBarcodeResponse{Copy: &library.Copy{Id: uuid.NewString(), Barcode: "LIB-0001"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Barcode validation error",
+			request:          &library.GetCopyByBarcodeRequest{},
+			expectedResponse: &library.GetCopyByBarcodeResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetCopyByBarcodeRequest{Barcode: "LIB-0001"},
+			expectedResponse: &library.GetCopyByBarcodeResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			copyUseCase.EXPECT().GetCopyByBarcode(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), copyUseCase, mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetCopyByBarcode(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestSetCopyStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.SetCopyStatusRequest
+		expectedResponse *library.SetCopyStatusResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.SetCopyStatusRequest{Id: uuid.NewString(), Status: library.CopyStatus_COPY_STATUS_IN_REPAIR},
+			expectedResponse: &library.SetCopyStatusResponse{Copy: &library.Copy{Status: library.CopyStatus_COPY_STATUS_IN_REPAIR}},
+			expectedError:    nil,
+		},
+		{
+			name:             "On loan status validation error",
+			request:          &library.SetCopyStatusRequest{Id: uuid.NewString(), Status: library.CopyStatus_COPY_STATUS_ON_LOAN},
+			expectedResponse: &library.SetCopyStatusResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.SetCopyStatusRequest{Id: uuid.NewString(), Status: library.CopyStatus_COPY_STATUS_IN_REPAIR},
+			expectedResponse: &library.SetCopyStatusResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			copyUseCase.EXPECT().SetCopyStatus(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), copyUseCase, mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.SetCopyStatus(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRetireCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RetireCopyRequest
+		expectedResponse *library.RetireCopyResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RetireCopyRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RetireCopyResponse{Copy: &library.Copy{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.RetireCopyRequest{Id: "123"},
+			expectedResponse: &library.RetireCopyResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RetireCopyRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RetireCopyResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			copyUseCase.EXPECT().RetireCopy(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), copyUseCase, mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RetireCopy(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRegisterPatron(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RegisterPatronRequest
+		expectedResponse *library.RegisterPatronResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RegisterPatronRequest{Name: "Jane Doe", Phone: "+15551234567", CardNumber: "P-0001", Tier: library.MembershipTier_MEMBERSHIP_TIER_STANDARD, MembershipMonths: 12},
+			expectedResponse: &library.RegisterPatronResponse{Patron: &library.Patron{Id: uuid.NewString(), Name: "Jane Doe"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Contact validation error",
+			request:          &library.RegisterPatronRequest{Name: "Jane Doe", CardNumber: "P-0001", Tier: library.MembershipTier_MEMBERSHIP_TIER_STANDARD, MembershipMonths: 12},
+			expectedResponse: &library.RegisterPatronResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RegisterPatronRequest{Name: "Jane Doe", Phone: "+15551234567", CardNumber: "P-0001", Tier: library.MembershipTier_MEMBERSHIP_TIER_STANDARD, MembershipMonths: 12},
+			expectedResponse: &library.RegisterPatronResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			patronUseCase.EXPECT().RegisterPatron(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), patronUseCase,
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RegisterPatron(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestUpdatePatron(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.UpdatePatronRequest
+		expectedResponse *library.UpdatePatronResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.UpdatePatronRequest{Id: uuid.NewString(), Name: "Jane Doe", Tier: library.MembershipTier_MEMBERSHIP_TIER_PREMIUM},
+			expectedResponse: &library.UpdatePatronResponse{Patron: &library.Patron{Name: "Jane Doe"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Tier validation error",
+			request:          &library.UpdatePatronRequest{Id: uuid.NewString(), Name: "Jane Doe"},
+			expectedResponse: &library.UpdatePatronResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.UpdatePatronRequest{Id: uuid.NewString(), Name: "Jane Doe", Tier: library.MembershipTier_MEMBERSHIP_TIER_PREMIUM},
+			expectedResponse: &library.UpdatePatronResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			patronUseCase.EXPECT().UpdatePatron(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), patronUseCase,
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.UpdatePatron(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetPatron(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.GetPatronRequest
+		expectedResponse *library.GetPatronResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.GetPatronRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetPatronResponse{Patron: &library.Patron{Name: "Jane Doe"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.GetPatronRequest{Id: "123"},
+			expectedResponse: &library.GetPatronResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.GetPatronRequest{Id: uuid.NewString()},
+			expectedResponse: &library.GetPatronResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			patronUseCase.EXPECT().GetPatron(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), patronUseCase,
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.GetPatron(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRenewMembership(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RenewMembershipRequest
+		expectedResponse *library.RenewMembershipResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RenewMembershipRequest{Id: uuid.NewString(), Months: 12},
+			expectedResponse: &library.RenewMembershipResponse{Patron: &library.Patron{Name: "Jane Doe"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Months validation error",
+			request:          &library.RenewMembershipRequest{Id: uuid.NewString(), Months: 61},
+			expectedResponse: &library.RenewMembershipResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RenewMembershipRequest{Id: uuid.NewString(), Months: 12},
+			expectedResponse: &library.RenewMembershipResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			patronUseCase.EXPECT().RenewMembership(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), patronUseCase,
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RenewMembership(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestBlockPatron(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.BlockPatronRequest
+		expectedResponse *library.BlockPatronResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.BlockPatronRequest{Id: uuid.NewString(), Blocked: true, Reason: "Lost items"},
+			expectedResponse: &library.BlockPatronResponse{Patron: &library.Patron{Blocked: true}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.BlockPatronRequest{Id: "123", Blocked: true},
+			expectedResponse: &library.BlockPatronResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.BlockPatronRequest{Id: uuid.NewString(), Blocked: true, Reason: "Lost items"},
+			expectedResponse: &library.BlockPatronResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			patronUseCase.EXPECT().BlockPatron(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl),
+				mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), patronUseCase,
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl),
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.BlockPatron(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestCheckoutCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.CheckoutCopyRequest
+		expectedResponse *library.CheckoutCopyResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.CheckoutCopyRequest{PatronId: uuid.NewString(), Barcode: "LIB-0001"},
+			expectedResponse: &library.CheckoutCopyResponse{Loan: &library.Loan{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Patron id validation error",
+			request:          &library.CheckoutCopyRequest{PatronId: "123", Barcode: "LIB-0001"},
+			expectedResponse: &library.CheckoutCopyResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.CheckoutCopyRequest{PatronId: uuid.NewString(), Barcode: "LIB-0001"},
+			expectedResponse: &library.CheckoutCopyResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			circulationUseCase := mocks.NewMockCirculationUseCase(ctrl)
+			circulationUseCase.EXPECT().CheckoutCopy(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), circulationUseCase,
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.CheckoutCopy(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestReturnCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ReturnCopyRequest
+		expectedResponse *library.ReturnCopyResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ReturnCopyRequest{Barcode: "LIB-0001"},
+			expectedResponse: &library.ReturnCopyResponse{Loan: &library.Loan{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Barcode validation error",
+			request:          &library.ReturnCopyRequest{},
+			expectedResponse: &library.ReturnCopyResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ReturnCopyRequest{Barcode: "LIB-0001"},
+			expectedResponse: &library.ReturnCopyResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			circulationUseCase := mocks.NewMockCirculationUseCase(ctrl)
+			circulationUseCase.EXPECT().ReturnCopy(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), circulationUseCase,
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ReturnCopy(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRenewLoan(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RenewLoanRequest
+		expectedResponse *library.RenewLoanResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RenewLoanRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RenewLoanResponse{Loan: &library.Loan{Renewals: 1}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Id validation error",
+			request:          &library.RenewLoanRequest{Id: "123"},
+			expectedResponse: &library.RenewLoanResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RenewLoanRequest{Id: uuid.NewString()},
+			expectedResponse: &library.RenewLoanResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			circulationUseCase := mocks.NewMockCirculationUseCase(ctrl)
+			circulationUseCase.EXPECT().RenewLoan(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), circulationUseCase,
+				mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl),
+				mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RenewLoan(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestPlaceHold(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.PlaceHoldRequest
+		expectedResponse *library.PlaceHoldResponse
+		expectedError    error
+	}{
+		{
+			name: "No error",
+			request: &library.PlaceHoldRequest{PatronId: uuid.NewString(), BookId: uuid.NewString(),
+				PickupBranchId: uuid.NewString()},
+			expectedResponse: &library.PlaceHoldResponse{Hold: &library.Hold{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid book id",
+			request:          &library.PlaceHoldRequest{PatronId: uuid.NewString(), BookId: "1"},
+			expectedResponse: &library.PlaceHoldResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name: "Internal error",
+			request: &library.PlaceHoldRequest{PatronId: uuid.NewString(), BookId: uuid.NewString(),
+				PickupBranchId: uuid.NewString()},
+			expectedResponse: &library.PlaceHoldResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			holdUseCase.EXPECT().PlaceHold(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), holdUseCase,
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.PlaceHold(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestCancelHold(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.CancelHoldRequest
+		expectedResponse *library.CancelHoldResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.CancelHoldRequest{Id: uuid.NewString()},
+			expectedResponse: &library.CancelHoldResponse{Hold: &library.Hold{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid id",
+			request:          &library.CancelHoldRequest{Id: "1"},
+			expectedResponse: &library.CancelHoldResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.CancelHoldRequest{Id: uuid.NewString()},
+			expectedResponse: &library.CancelHoldResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			holdUseCase.EXPECT().CancelHold(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), holdUseCase,
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.CancelHold(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListHolds(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListHoldsRequest
+		expectedResponse *library.ListHoldsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListHoldsRequest{BookId: uuid.NewString()},
+			expectedResponse: &library.ListHoldsResponse{Holds: []*library.Hold{{Id: uuid.NewString()}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Empty filter",
+			request:          &library.ListHoldsRequest{},
+			expectedResponse: &library.ListHoldsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListHoldsRequest{BookId: uuid.NewString()},
+			expectedResponse: &library.ListHoldsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			holdUseCase.EXPECT().ListHolds(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl),
+				mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), holdUseCase,
+				mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListHolds(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListFines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListFinesRequest
+		expectedResponse *library.ListFinesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListFinesRequest{PatronId: uuid.NewString()},
+			expectedResponse: &library.ListFinesResponse{Fines: []*library.Fine{{Id: uuid.NewString()}}, BalanceCents: 100},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid patron id",
+			request:          &library.ListFinesRequest{PatronId: "1"},
+			expectedResponse: &library.ListFinesResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListFinesRequest{PatronId: uuid.NewString()},
+			expectedResponse: &library.ListFinesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fineUseCase := mocks.NewMockFineUseCase(ctrl)
+			fineUseCase.EXPECT().ListFines(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), fineUseCase,
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListFines(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestPayFine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.PayFineRequest
+		expectedResponse *library.PayFineResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.PayFineRequest{Id: uuid.NewString(), AmountCents: 100},
+			expectedResponse: &library.PayFineResponse{Fine: &library.Fine{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Zero amount",
+			request:          &library.PayFineRequest{Id: uuid.NewString()},
+			expectedResponse: &library.PayFineResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.PayFineRequest{Id: uuid.NewString(), AmountCents: 100},
+			expectedResponse: &library.PayFineResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fineUseCase := mocks.NewMockFineUseCase(ctrl)
+			fineUseCase.EXPECT().PayFine(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), fineUseCase,
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.PayFine(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestWaiveFine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.WaiveFineRequest
+		expectedResponse *library.WaiveFineResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.WaiveFineRequest{Id: uuid.NewString(), Reason: "damaged on delivery"},
+			expectedResponse: &library.WaiveFineResponse{Fine: &library.Fine{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Empty reason",
+			request:          &library.WaiveFineRequest{Id: uuid.NewString()},
+			expectedResponse: &library.WaiveFineResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.WaiveFineRequest{Id: uuid.NewString(), Reason: "damaged on delivery"},
+			expectedResponse: &library.WaiveFineResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fineUseCase := mocks.NewMockFineUseCase(ctrl)
+			fineUseCase.EXPECT().WaiveFine(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), fineUseCase,
+				mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.WaiveFine(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestCreateBranch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.CreateBranchRequest
+		expectedResponse *library.CreateBranchResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.CreateBranchRequest{Name: "North"},
+			expectedResponse: &library.CreateBranchResponse{Branch: &library.Branch{Id: uuid.NewString(), Name: "North"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Empty name",
+			request:          &library.CreateBranchRequest{Address: "1 Main St"},
+			expectedResponse: &library.CreateBranchResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.CreateBranchRequest{Name: "North"},
+			expectedResponse: &library.CreateBranchResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().CreateBranch(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.CreateBranch(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListBranchesRequest
+		expectedResponse *library.ListBranchesResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListBranchesRequest{},
+			expectedResponse: &library.ListBranchesResponse{Branches: []*library.Branch{{Id: uuid.NewString()}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListBranchesRequest{},
+			expectedResponse: &library.ListBranchesResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().ListBranches(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListBranches(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestRequestTransfer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RequestTransferRequest
+		expectedResponse *library.RequestTransferResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			expectedResponse: &library.RequestTransferResponse{Transfer: &library.Transfer{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid branch id",
+			request:          &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: "invalid"},
+			expectedResponse: &library.RequestTransferResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			expectedResponse: &library.RequestTransferResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().RequestTransfer(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.RequestTransfer(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestShipTransfer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ShipTransferRequest
+		expectedResponse *library.ShipTransferResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ShipTransferRequest{Id: uuid.NewString()},
+			expectedResponse: &library.ShipTransferResponse{Transfer: &library.Transfer{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid id",
+			request:          &library.ShipTransferRequest{Id: "invalid"},
+			expectedResponse: &library.ShipTransferResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ShipTransferRequest{Id: uuid.NewString()},
+			expectedResponse: &library.ShipTransferResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().ShipTransfer(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ShipTransfer(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ReceiveTransferRequest
+		expectedResponse *library.ReceiveTransferResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ReceiveTransferRequest{Id: uuid.NewString()},
+			expectedResponse: &library.ReceiveTransferResponse{Transfer: &library.Transfer{Id: uuid.NewString()}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid id",
+			request:          &library.ReceiveTransferRequest{Id: "invalid"},
+			expectedResponse: &library.ReceiveTransferResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ReceiveTransferRequest{Id: uuid.NewString()},
+			expectedResponse: &library.ReceiveTransferResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().ReceiveTransfer(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ReceiveTransfer(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListTransfers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListTransfersRequest
+		expectedResponse *library.ListTransfersResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListTransfersRequest{BranchId: uuid.NewString()},
+			expectedResponse: &library.ListTransfersResponse{Transfers: []*library.Transfer{{Id: uuid.NewString()}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Received status",
+			request:          &library.ListTransfersRequest{Status: library.TransferStatus_TRANSFER_STATUS_RECEIVED},
+			expectedResponse: &library.ListTransfersResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListTransfersRequest{BranchId: uuid.NewString()},
+			expectedResponse: &library.ListTransfersResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+			branchUseCase.EXPECT().ListTransfers(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl),
+				mocks.NewMockPatronUseCase(ctrl), mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), branchUseCase, mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.ListTransfers(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.SubmitReviewRequest
+		expectedResponse *library.SubmitReviewResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.SubmitReviewRequest{BookId: "00000000-0000-0000-0000-000000000001", PatronId: "00000000-0000-0000-0000-000000000001", Rating: 5},
+			expectedResponse: &library.SubmitReviewResponse{Review: &library.Review{Id: "00000000-0000-0000-0000-000000000001"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid rating",
+			request:          &library.SubmitReviewRequest{BookId: "00000000-0000-0000-0000-000000000001", PatronId: "00000000-0000-0000-0000-000000000001", Rating: 6},
+			expectedResponse: &library.SubmitReviewResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.SubmitReviewRequest{BookId: "00000000-0000-0000-0000-000000000001", PatronId: "00000000-0000-0000-0000-000000000001", Rating: 5},
+			expectedResponse: &library.SubmitReviewResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			reviewUseCase.EXPECT().SubmitReview(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), reviewUseCase)
+
+			ctx := context.Background()
+			response, err := service.SubmitReview(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListBookReviews(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ListBookReviewsRequest
+		expectedResponse *library.ListBookReviewsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ListBookReviewsRequest{BookId: "00000000-0000-0000-0000-000000000001"},
+			expectedResponse: &library.ListBookReviewsResponse{Reviews: []*library.Review{{Id: "00000000-0000-0000-0000-000000000001"}}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid book id",
+			request:          &library.ListBookReviewsRequest{BookId: "123"},
+			expectedResponse: &library.ListBookReviewsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ListBookReviewsRequest{BookId: "00000000-0000-0000-0000-000000000001"},
+			expectedResponse: &library.ListBookReviewsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			reviewUseCase.EXPECT().ListBookReviews(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), reviewUseCase)
+
+			ctx := context.Background()
+			response, err := service.ListBookReviews(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestModerateReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.ModerateReviewRequest
+		expectedResponse *library.ModerateReviewResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.ModerateReviewRequest{Id: "00000000-0000-0000-0000-000000000001", Status: library.ReviewStatus_REVIEW_STATUS_APPROVED},
+			expectedResponse: &library.ModerateReviewResponse{Review: &library.Review{Id: "00000000-0000-0000-0000-000000000001"}},
+			expectedError:    nil,
+		},
+		{
+			name:             "Invalid status",
+			request:          &library.ModerateReviewRequest{Id: "00000000-0000-0000-0000-000000000001", Status: library.ReviewStatus_REVIEW_STATUS_PENDING},
+			expectedResponse: &library.ModerateReviewResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.ModerateReviewRequest{Id: "00000000-0000-0000-0000-000000000001", Status: library.ReviewStatus_REVIEW_STATUS_APPROVED},
+			expectedResponse: &library.ModerateReviewResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			reviewUseCase.EXPECT().ModerateReview(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), reviewUseCase)
+
+			ctx := context.Background()
+			response, err := service.ModerateReview(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestFindDuplicateAuthors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.FindDuplicateAuthorsRequest
+		expectedResponse *library.FindDuplicateAuthorsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.FindDuplicateAuthorsRequest{MinSimilarity: 0.7},
+			expectedResponse: &library.FindDuplicateAuthorsResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Similarity validation error",
+			request:          &library.FindDuplicateAuthorsRequest{MinSimilarity: 1.5},
+			expectedResponse: &library.FindDuplicateAuthorsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.FindDuplicateAuthorsRequest{MinSimilarity: 0.7},
+			expectedResponse: &library.FindDuplicateAuthorsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().FindDuplicateAuthors(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.FindDuplicateAuthors(ctx, tc.request)
+
+			if tc.expectedError != nil {
+				s, ok := status.FromError(err)
+				expS, expOk := status.FromError(tc.expectedError)
+				require.Equal(t, expOk, ok)
+				if ok {
+					require.Equal(t, s.Code(), expS.Code())
+				}
+			} else {
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestMergeAuthors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.MergeAuthorsRequest
+		expectedResponse *library.MergeAuthorsResponse
+		expectedError    error
+	}{
+		{
+			name:             "No error",
+			request:          &library.MergeAuthorsRequest{SourceIds: []string{uuid.NewString()}, TargetId: uuid.NewString()},
+			expectedResponse: &library.MergeAuthorsResponse{},
+			expectedError:    nil,
+		},
+		{
+			name:             "Sources validation error",
+			request:          &library.MergeAuthorsRequest{TargetId: uuid.NewString()},
+			expectedResponse: &library.MergeAuthorsResponse{},
+			expectedError:    status.Error(codes.InvalidArgument, "test"),
+		},
+		{
+			name:             "Internal error",
+			request:          &library.MergeAuthorsRequest{SourceIds: []string{uuid.NewString()}, TargetId: uuid.NewString()},
+			expectedResponse: &library.MergeAuthorsResponse{},
+			expectedError:    status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			authorUseCase.EXPECT().MergeAuthors(gomock.Any(), tc.request).
+				Return(tc.expectedResponse, tc.expectedError).AnyTimes()
+
+			logger := zap.NewNop()
+			booksUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := New(logger, booksUseCase, authorUseCase, mocks.NewMockChangesUseCase(ctrl),
+				mocks.NewMockPublisherUseCase(ctrl), mocks.NewMockGenreUseCase(ctrl), mocks.NewMockSeriesUseCase(ctrl), mocks.NewMockWorkUseCase(ctrl), mocks.NewMockCopyUseCase(ctrl), mocks.NewMockPatronUseCase(ctrl),
+				mocks.NewMockCirculationUseCase(ctrl), mocks.NewMockHoldUseCase(ctrl), mocks.NewMockFineUseCase(ctrl), mocks.NewMockBranchUseCase(ctrl), mocks.NewMockReviewUseCase(ctrl))
+
+			ctx := context.Background()
+			response, err := service.MergeAuthors(ctx, tc.request)
+
 			if tc.expectedError != nil {
 				s, ok := status.FromError(err)
 				expS, expOk := status.FromError(tc.expectedError)
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) SetBookSeries(ctx context.Context, request *library.SetBookSeriesRequest) (*library.SetBookSeriesResponse, error) {
+	i.logger.Info("Validating set book series request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating set book series request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.seriesUseCase.SetBookSeries(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during set book series request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Set book series request has passed successfully.")
+
+	return resp, nil
+}
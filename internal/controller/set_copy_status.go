@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) SetCopyStatus(ctx context.Context, request *library.SetCopyStatusRequest) (*library.SetCopyStatusResponse, error) {
+	i.logger.Info("Validating set copy status request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating set copy status request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.copyUseCase.SetCopyStatus(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during set copy status request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Set copy status request has passed successfully.")
+
+	return resp, nil
+}
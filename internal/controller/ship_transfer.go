@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ShipTransfer(ctx context.Context, request *library.ShipTransferRequest) (*library.ShipTransferResponse, error) {
+	i.logger.Info("Validating ship transfer request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating ship transfer request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.branchUseCase.ShipTransfer(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during ship transfer request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Ship transfer request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) StreamChanges(request *library.StreamChangesRequest, server library.Library_StreamChangesServer) error {
+	i.logger.Info("Validating stream changes request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating stream changes request.", zap.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.changesUseCase.StreamChanges(server.Context(), request, server)
+
+	if err != nil {
+		i.logger.Error("Error during stream changes request.", zap.Error(err))
+		return err
+	}
+
+	i.logger.Info("Stream changes request has passed successfully.")
+
+	return nil
+}
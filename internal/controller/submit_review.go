@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) SubmitReview(ctx context.Context, request *library.SubmitReviewRequest) (*library.SubmitReviewResponse, error) {
+	i.logger.Info("Validating submit review request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating submit review request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.reviewUseCase.SubmitReview(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during submit review request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Submit review request has passed successfully.")
+
+	return resp, nil
+}
@@ -17,6 +17,26 @@ func (i *implementation) UpdateBook(ctx context.Context, request *library.Update
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if len(request.GetAuthorIds()) > 0 && len(request.GetContributors()) > 0 {
+		i.logger.Error("Error during validating update book request.", zap.Error(errAuthorsTwice))
+		return nil, status.Error(codes.InvalidArgument, errAuthorsTwice.Error())
+	}
+
+	if request.GetIsbn() != "" {
+		isbn, err := normalizeISBN(request.GetIsbn())
+
+		if err != nil {
+			i.logger.Error("Error during validating update book request.", zap.Error(err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		request.Isbn = isbn
+	}
+
+	if request.GetEtag() == "" {
+		request.Etag = getIfMatch(ctx)
+	}
+
 	resp, err := i.booksUseCase.UpdateBook(ctx, request)
 
 	if err != nil {
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) UpdateGenre(ctx context.Context, request *library.UpdateGenreRequest) (*library.UpdateGenreResponse, error) {
+	i.logger.Info("Validating update genre request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating update genre request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.genreUseCase.UpdateGenre(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during update genre request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Update genre request has passed successfully.")
+
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errPatronName = errors.New("patron name can not be empty")
+	errPatronTier = errors.New("membership tier must be specified")
+)
+
+func (i *implementation) UpdatePatron(ctx context.Context, request *library.UpdatePatronRequest) (*library.UpdatePatronResponse, error) {
+	i.logger.Info("Validating update patron request.")
+
+	if err := validateUpdatePatronRequest(request); err != nil {
+		i.logger.Error("Error during validating update patron request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if request.GetEtag() == "" {
+		request.Etag = getIfMatch(ctx)
+	}
+
+	resp, err := i.patronUseCase.UpdatePatron(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during update patron request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Update patron request has passed successfully.")
+
+	return resp, nil
+}
+
+// validateUpdatePatronRequest rejects clearing the name or the tier, an empty mask replaces both.
+func validateUpdatePatronRequest(request *library.UpdatePatronRequest) error {
+	if err := request.ValidateAll(); err != nil {
+		return err
+	}
+
+	paths := request.GetUpdateMask().GetPaths()
+	replaces := func(path string) bool {
+		return len(paths) == 0 || slices.Contains(paths, path)
+	}
+
+	if replaces("name") && request.GetName() == "" {
+		return errPatronName
+	}
+
+	if replaces("tier") && request.GetTier() == library.MembershipTier_MEMBERSHIP_TIER_UNSPECIFIED {
+		return errPatronTier
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) WaiveFine(ctx context.Context, request *library.WaiveFineRequest) (*library.WaiveFineResponse, error) {
+	i.logger.Info("Validating waive fine request.")
+
+	if err := request.ValidateAll(); err != nil {
+		i.logger.Error("Error during validating waive fine request.", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := i.fineUseCase.WaiveFine(ctx, request)
+
+	if err != nil {
+		i.logger.Error("Error during waive fine request.", zap.Error(err))
+		return nil, err
+	}
+
+	i.logger.Info("Waive fine request has passed successfully.")
+
+	return resp, nil
+}
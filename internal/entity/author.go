@@ -1,10 +1,89 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 type Author struct {
+	ID        string
+	Name      string
+	Version   int64
+	DeletedAt *time.Time
+	// Only the date part of the life dates is stored.
+	BirthDate *time.Time
+	DeathDate *time.Time
+	// Nationality is an ISO 3166-1 alpha-2 code.
+	Nationality string
+	Biography   string
+	// Aliases are alternative names and pseudonyms, lookups by name also match them.
+	Aliases []string
+}
+
+// AuthorUpdate holds the fields to change, nil fields are left as they are, zero values clear them.
+// A zero ExpectedVersion skips the optimistic concurrency check.
+type AuthorUpdate struct {
+	ID              string
+	Name            *string
+	BirthDate       *time.Time
+	DeathDate       *time.Time
+	Nationality     *string
+	Biography       *string
+	Aliases         *[]string
+	ExpectedVersion int64
+}
+
+type AuthorNameMatch int
+
+const (
+	AuthorNameMatchPrefix AuthorNameMatch = iota
+	AuthorNameMatchExact
+)
+
+type AuthorFilter struct {
+	Name            string
+	NameMatch       AuthorNameMatch
+	CaseInsensitive bool
+}
+
+type AuthorCursor struct {
 	ID   string
 	Name string
 }
 
-var ErrAuthorNotFound = errors.New("author not found")
+type ListAuthorsParams struct {
+	Filter AuthorFilter
+	After  *AuthorCursor
+	Limit  int
+}
+
+// FindDuplicateAuthorsParams selects pairs of authors whose names have at least MinSimilarity trigram similarity,
+// only the pairs including AuthorID when it is set.
+type FindDuplicateAuthorsParams struct {
+	AuthorID      string
+	MinSimilarity float64
+	Limit         int
+}
+
+// DuplicateAuthors is a pair of possibly duplicate authors, the most similar pairs come first.
+type DuplicateAuthors struct {
+	AuthorID      string
+	AuthorName    string
+	DuplicateID   string
+	DuplicateName string
+	Similarity    float64
+}
+
+// AuthorMerge is the result of merging the sources into the target, the sources are deleted. Books are the
+// editions of the works moved to the target, as they are after the merge.
+type AuthorMerge struct {
+	Target  Author
+	Sources []Author
+	Books   []Book
+}
+
+var (
+	ErrAuthorNotFound  = errors.New("author not found")
+	ErrAuthorLifeDates = errors.New("death date is before birth date")
+	ErrAuthorSelfMerge = errors.New("author cannot be merged into itself")
+)
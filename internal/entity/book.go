@@ -11,6 +11,96 @@ type Book struct {
 	AuthorIDs []string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   int64
+	DeletedAt *time.Time
+	// ISBN is stored normalized to ISBN-13.
+	ISBN            string
+	PublicationYear int
+	Language        string
+	PageCount       int
+	Description     string
+	Subtitle        string
+	PublisherID     string
+	GenreIDs        []string
+	// WorkID is the work the book is an edition of, AuthorIDs are the authors of the work.
+	WorkID string
+	// Contributors are the authors of the work with their roles in display order.
+	Contributors []Contributor
 }
 
-var ErrBookNotFound = errors.New("book not found")
+type ContributorRole int
+
+const (
+	ContributorRoleAuthor ContributorRole = iota + 1
+	ContributorRoleTranslator
+	ContributorRoleIllustrator
+	ContributorRoleEditor
+)
+
+// Contributor links an author to a work, positions start from one.
+type Contributor struct {
+	AuthorID string
+	Role     ContributorRole
+	Position int
+}
+
+type BookOrderBy int
+
+const (
+	BookOrderByName BookOrderBy = iota
+	BookOrderByCreatedAt
+)
+
+type BookFilter struct {
+	NamePrefix string
+	AuthorID   string
+	// AuthorRole restricts AuthorID to the given role, any role matches when it is zero.
+	AuthorRole  ContributorRole
+	PublisherID string
+	GenreID     string
+	// IncludeSubgenres also matches books of every genre below GenreID.
+	IncludeSubgenres bool
+	CreatedAfter     *time.Time
+	CreatedBefore    *time.Time
+	UpdatedAfter     *time.Time
+	UpdatedBefore    *time.Time
+}
+
+type BookCursor struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+type ListBooksParams struct {
+	Filter     BookFilter
+	OrderBy    BookOrderBy
+	Descending bool
+	After      *BookCursor
+	Limit      int
+}
+
+// BookUpdate holds the fields to change, nil fields are left as they are.
+// A zero ExpectedVersion skips the optimistic concurrency check. Contributors replace the authors
+// together with their roles, while AuthorIDs keep the roles of the authors that remain.
+type BookUpdate struct {
+	ID              string
+	Name            *string
+	AuthorIDs       *[]string
+	Contributors    *[]Contributor
+	ISBN            *string
+	PublicationYear *int
+	Language        *string
+	PageCount       *int
+	Description     *string
+	Subtitle        *string
+	PublisherID     *string
+	GenreIDs        *[]string
+	ExpectedVersion int64
+}
+
+var (
+	ErrBookNotFound      = errors.New("book not found")
+	ErrBookISBNExists    = errors.New("book with this isbn already exists")
+	ErrBookInCirculation = errors.New("book has active loans, holds or transfers")
+)
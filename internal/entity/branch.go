@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Branch struct {
+	ID        string
+	Name      string
+	Address   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var (
+	ErrBranchNotFound = errors.New("branch not found")
+	ErrBranchExists   = errors.New("branch with this name already exists")
+)
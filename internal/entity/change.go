@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type ChangeKind int
+
+const (
+	ChangeKindBook ChangeKind = iota + 1
+	ChangeKindAuthor
+)
+
+type ChangeOperation int
+
+const (
+	ChangeOperationCreated ChangeOperation = iota + 1
+	ChangeOperationUpdated
+	ChangeOperationDeleted
+	ChangeOperationRestored
+)
+
+// Change is an entry of the change log, Book or Author is set according to Kind.
+type Change struct {
+	Sequence  int64
+	Kind      ChangeKind
+	Operation ChangeOperation
+	Book      *Book
+	Author    *Author
+	CreatedAt time.Time
+}
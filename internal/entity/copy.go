@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type CopyStatus int
+
+const (
+	CopyStatusAvailable CopyStatus = iota + 1
+	CopyStatusOnLoan
+	CopyStatusLost
+	CopyStatusDamaged
+	CopyStatusInRepair
+	CopyStatusOnHold
+	CopyStatusInTransit
+)
+
+// Copy is a physical item of a book, retired copies are kept with RetiredAt set. It belongs to its home branch and
+// is shelved at the current one.
+type Copy struct {
+	ID              string
+	BookID          string
+	HomeBranchID    string
+	CurrentBranchID string
+	Barcode         string
+	ShelfLocation   string
+	AcquiredOn      *time.Time
+	PriceCents      int64
+	Status          CopyStatus
+	RetiredAt       *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// BranchCopyCounts counts the copies of a book at a branch that are not retired.
+type BranchCopyCounts struct {
+	BranchID   string
+	BranchName string
+	Total      int
+	Available  int
+}
+
+var (
+	ErrCopyNotFound      = errors.New("copy not found")
+	ErrCopyBarcodeExists = errors.New("copy with this barcode already exists")
+	ErrCopyOnLoan        = errors.New("copy is on loan")
+	ErrCopyOnHold        = errors.New("copy is held for pickup")
+	ErrCopyRetired       = errors.New("copy is retired")
+	ErrCopyInTransit     = errors.New("copy is in transit")
+	ErrCopyAtOtherBranch = errors.New("copy is at another branch")
+)
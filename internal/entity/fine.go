@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// FinePolicy holds the fine rules of a membership tier with the defaults applied.
+type FinePolicy struct {
+	Tier                MembershipTier
+	DailyRateCents      int64
+	GraceDays           int
+	MaxFineCents        int64
+	BlockThresholdCents int64
+}
+
+type FineStatus int
+
+const (
+	// FineStatusAccruing is a fine of a loan that is still out, it grows until the copy is returned.
+	FineStatusAccruing FineStatus = iota + 1
+	FineStatusUnpaid
+	FineStatusPaid
+	FineStatusWaived
+)
+
+type FineEventKind int
+
+const (
+	FineEventAssessed FineEventKind = iota + 1
+	FineEventPaid
+	FineEventWaived
+)
+
+type FineEvent struct {
+	Kind        FineEventKind
+	AmountCents int64
+	Note        string
+	CreatedAt   time.Time
+}
+
+type Fine struct {
+	ID          string
+	LoanID      string
+	PatronID    string
+	AmountCents int64
+	PaidCents   int64
+	Status      FineStatus
+	Events      []FineEvent
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Open reports whether the fine can still be paid or waived.
+func (f Fine) Open() bool {
+	return f.Status == FineStatusAccruing || f.Status == FineStatusUnpaid
+}
+
+// OutstandingCents is the part of an open fine that is not paid yet.
+func (f Fine) OutstandingCents() int64 {
+	if !f.Open() {
+		return 0
+	}
+
+	return f.AmountCents - f.PaidCents
+}
+
+var (
+	ErrFineNotFound        = errors.New("fine not found")
+	ErrFineClosed          = errors.New("fine is already paid or waived")
+	ErrFineOverpaid        = errors.New("payment exceeds the outstanding amount")
+	ErrFinePolicyNotFound  = errors.New("fine policy not found")
+	ErrFineBalanceExceeded = errors.New("patron has unpaid fines over the limit")
+)
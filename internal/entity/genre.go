@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Genre is a node of the subject taxonomy, root genres have an empty ParentID.
+type Genre struct {
+	ID        string
+	Name      string
+	ParentID  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GenreUpdate holds the fields to change, nil fields are left as they are.
+// An empty ParentID moves the genre to the root.
+type GenreUpdate struct {
+	ID       string
+	Name     *string
+	ParentID *string
+}
+
+var (
+	ErrGenreNotFound    = errors.New("genre not found")
+	ErrGenreExists      = errors.New("genre with this name already exists under the parent")
+	ErrGenreCycle       = errors.New("genre can not be moved under itself or its subgenre")
+	ErrGenreHasChildren = errors.New("genre has subgenres")
+)
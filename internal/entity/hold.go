@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type HoldStatus int
+
+const (
+	HoldStatusWaiting HoldStatus = iota + 1
+	HoldStatusReady
+	HoldStatusFulfilled
+	HoldStatusCancelled
+	HoldStatusExpired
+	// HoldStatusInTransit is a hold whose copy is being sent to the pickup branch.
+	HoldStatusInTransit
+)
+
+// Hold is a place of a patron in the queue of a book, CopyID is set once a copy is held for pickup.
+type Hold struct {
+	ID             string
+	BookID         string
+	PatronID       string
+	PickupBranchID string
+	CopyID         string
+	Status         HoldStatus
+	Position       int
+	ReadyUntil     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Active reports whether the hold is still waiting, in transit or ready for pickup.
+func (h Hold) Active() bool {
+	return h.Status == HoldStatusWaiting || h.Status == HoldStatusReady || h.Status == HoldStatusInTransit
+}
+
+// HoldFilter selects active holds, empty fields are not filtered.
+type HoldFilter struct {
+	BookID   string
+	PatronID string
+}
+
+var (
+	ErrHoldNotFound  = errors.New("hold not found")
+	ErrHoldExists    = errors.New("patron already has a hold on this book")
+	ErrHoldNotNeeded = errors.New("book has available copies")
+	ErrHoldClosed    = errors.New("hold is no longer active")
+)
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// LoanPolicy holds the loan rules of a membership tier.
+type LoanPolicy struct {
+	Tier        MembershipTier
+	LoanDays    int
+	MaxLoans    int
+	MaxRenewals int
+}
+
+type Loan struct {
+	ID           string
+	CopyID       string
+	BookID       string
+	PatronID     string
+	BranchID     string
+	CheckedOutAt time.Time
+	DueAt        time.Time
+	ReturnedAt   *time.Time
+	Renewals     int
+}
+
+// Overdue reports whether the loan was due before now.
+func (l Loan) Overdue(now time.Time) bool {
+	return l.DueAt.Before(now)
+}
+
+var (
+	ErrLoanNotFound        = errors.New("loan not found")
+	ErrLoanReturned        = errors.New("loan is already returned")
+	ErrLoanPolicyNotFound  = errors.New("loan policy not found")
+	ErrLoanLimitReached    = errors.New("patron has reached the maximum number of loans")
+	ErrRenewalLimitReached = errors.New("loan has reached the maximum number of renewals")
+	ErrLoanFined           = errors.New("loan has an accruing fine")
+	ErrLoanOverdue         = errors.New("loan is overdue")
+	ErrCopyNotAvailable    = errors.New("copy is not available")
+	ErrPatronBlocked       = errors.New("patron is blocked")
+	ErrMembershipExpired   = errors.New("membership has expired")
+)
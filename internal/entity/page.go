@@ -0,0 +1,5 @@
+package entity
+
+import "errors"
+
+var ErrInvalidPageToken = errors.New("invalid page token")
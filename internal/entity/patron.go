@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type MembershipTier int
+
+const (
+	MembershipTierStandard MembershipTier = iota + 1
+	MembershipTierStudent
+	MembershipTierPremium
+)
+
+// Patron is a library member, at least one of Email and Phone is set.
+type Patron struct {
+	ID          string
+	Name        string
+	Email       string
+	Phone       string
+	CardNumber  string
+	Tier        MembershipTier
+	ExpiresOn   time.Time
+	Blocked     bool
+	BlockReason string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int64
+}
+
+type PatronUpdate struct {
+	ID              string
+	Name            *string
+	Email           *string
+	Phone           *string
+	Tier            *MembershipTier
+	ExpectedVersion int64
+}
+
+var (
+	ErrPatronNotFound        = errors.New("patron not found")
+	ErrPatronCardExists      = errors.New("patron with this card number already exists")
+	ErrPatronContactRequired = errors.New("patron must have an email or a phone")
+)
+
+// MembershipExpired reports whether the membership ended before the day of now, it is valid through ExpiresOn.
+func (p Patron) MembershipExpired(now time.Time) bool {
+	return !now.Before(p.ExpiresOn.AddDate(0, 0, 1))
+}
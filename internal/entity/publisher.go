@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Publisher struct {
+	ID string
+	// Name is the only required field, Country is an ISO 3166-1 alpha-2 code.
+	Name      string
+	Country   string
+	Website   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   int64
+}
+
+var ErrPublisherNotFound = errors.New("publisher not found")
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type ReviewStatus int
+
+const (
+	ReviewStatusPending ReviewStatus = iota + 1
+	ReviewStatusApproved
+	ReviewStatusRejected
+)
+
+// Review is a rating of a book by a patron, only approved reviews are shown and counted in the book rating.
+type Review struct {
+	ID             string
+	BookID         string
+	PatronID       string
+	Rating         int
+	Text           string
+	Status         ReviewStatus
+	ModerationNote string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type ReviewCursor struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+// ListReviewsParams selects reviews of the book in the status, the newest first.
+type ListReviewsParams struct {
+	BookID string
+	Status ReviewStatus
+	After  *ReviewCursor
+	Limit  int
+}
+
+// BookRating aggregates approved reviews of a book.
+type BookRating struct {
+	BookID      string
+	RatingSum   int64
+	ReviewCount int
+}
+
+// Average returns the average rating, zero when the book has no approved reviews.
+func (b BookRating) Average() float64 {
+	if b.ReviewCount == 0 {
+		return 0
+	}
+
+	return float64(b.RatingSum) / float64(b.ReviewCount)
+}
+
+var (
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewExists   = errors.New("patron has already reviewed this book")
+)
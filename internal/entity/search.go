@@ -0,0 +1,32 @@
+package entity
+
+type SearchResultKind int
+
+const (
+	SearchResultKindBook SearchResultKind = iota + 1
+	SearchResultKindAuthor
+)
+
+type SearchResult struct {
+	Kind    SearchResultKind
+	ID      string
+	Name    string
+	Snippet string
+	Score   float32
+}
+
+// SearchCursor is the last result of a page, results are ordered by descending score, then by kind and id.
+type SearchCursor struct {
+	Score float32
+	Kind  SearchResultKind
+	ID    string
+}
+
+// SearchParams searches only the books in Language when it is set, a primary language subtag. Authors are always
+// searched.
+type SearchParams struct {
+	Query    string
+	Language string
+	After    *SearchCursor
+	Limit    int
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Series groups books in reading order, Volumes are sorted by volume number.
+type Series struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Volumes     []SeriesVolume
+}
+
+// SeriesVolume is the place of a book in a series, volumes may be fractional like 2.5.
+// SeriesName is filled only when the volume is looked up by book.
+type SeriesVolume struct {
+	SeriesID   string
+	SeriesName string
+	BookID     string
+	Volume     float64
+}
+
+var (
+	ErrSeriesNotFound    = errors.New("series not found")
+	ErrBookNotInSeries   = errors.New("book is not in the series")
+	ErrSeriesVolumeTaken = errors.New("volume is already taken in the series")
+)
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type TransferStatus int
+
+const (
+	TransferStatusRequested TransferStatus = iota + 1
+	TransferStatusInTransit
+	TransferStatusReceived
+	TransferStatusCancelled
+)
+
+// Transfer moves a copy from its current branch to another one.
+type Transfer struct {
+	ID           string
+	CopyID       string
+	FromBranchID string
+	ToBranchID   string
+	Status       TransferStatus
+	ShippedAt    *time.Time
+	ReceivedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TransferFilter selects active transfers from or to the branch, a zero status selects both active statuses.
+type TransferFilter struct {
+	BranchID string
+	Status   TransferStatus
+}
+
+var (
+	ErrTransferNotFound     = errors.New("transfer not found")
+	ErrTransferExists       = errors.New("copy is already being transferred")
+	ErrTransferSameBranch   = errors.New("copy is already at this branch")
+	ErrTransferNotRequested = errors.New("transfer is not waiting for shipment")
+	ErrTransferNotInTransit = errors.New("transfer is not in transit")
+)
@@ -0,0 +1,9 @@
+package entity
+
+import "errors"
+
+var (
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	ErrInvalidEtag       = errors.New("invalid etag")
+	ErrVersionMismatch   = errors.New("version mismatch")
+)
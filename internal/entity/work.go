@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Work is the abstract title, Editions are its books ordered by publication year.
+type Work struct {
+	ID        string
+	Name      string
+	AuthorIDs []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Editions  []Book
+}
+
+var ErrWorkNotFound = errors.New("work not found")
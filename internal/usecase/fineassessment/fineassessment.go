@@ -0,0 +1,45 @@
+package fineassessment
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/usecase/periodic"
+	"github.com/project/library/internal/usecase/repository"
+	"go.uber.org/zap"
+)
+
+type FineAssessment interface {
+	Start(ctx context.Context, interval time.Duration)
+}
+
+var _ FineAssessment = (*fineAssessmentImpl)(nil)
+
+type fineAssessmentImpl struct {
+	logger         *zap.Logger
+	fineRepository repository.FineRepository
+}
+
+func New(
+	logger *zap.Logger,
+	fineRepository repository.FineRepository,
+) *fineAssessmentImpl {
+	return &fineAssessmentImpl{
+		logger:         logger,
+		fineRepository: fineRepository,
+	}
+}
+
+func (f *fineAssessmentImpl) Start(ctx context.Context, interval time.Duration) {
+	periodic.Start(ctx, interval, f.assess)
+}
+
+func (f *fineAssessmentImpl) assess(ctx context.Context) {
+	fines, err := f.fineRepository.AssessOverdueFines(ctx)
+
+	if err != nil {
+		f.logger.Error("can not assess overdue fines", zap.Error(err))
+	} else {
+		f.logger.Info("overdue fines assessed", zap.Int64("count", fines))
+	}
+}
@@ -0,0 +1,42 @@
+package fineassessment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/project/library/generated/mocks"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestFineAssessment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		assessError error
+	}{
+		{
+			name: "assessment without errors",
+		},
+		{
+			name:        "assessment with repository errors",
+			assessError: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().AssessOverdueFines(ctx).Return(int64(1), tc.assessError)
+
+			New(zap.NewNop(), fineRepo).assess(ctx)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package holdexpiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/periodic"
+	"go.uber.org/zap"
+)
+
+type HoldExpiry interface {
+	Start(ctx context.Context, interval time.Duration)
+}
+
+var _ HoldExpiry = (*holdExpiryImpl)(nil)
+
+type holdExpiryImpl struct {
+	logger  *zap.Logger
+	expirer library.HoldExpiryUseCase
+}
+
+func New(
+	logger *zap.Logger,
+	expirer library.HoldExpiryUseCase,
+) *holdExpiryImpl {
+	return &holdExpiryImpl{
+		logger:  logger,
+		expirer: expirer,
+	}
+}
+
+func (h *holdExpiryImpl) Start(ctx context.Context, interval time.Duration) {
+	periodic.Start(ctx, interval, h.expire)
+}
+
+func (h *holdExpiryImpl) expire(ctx context.Context) {
+	expired, err := h.expirer.ExpireHolds(ctx)
+
+	if err != nil {
+		h.logger.Error("can not expire holds", zap.Error(err))
+	} else {
+		h.logger.Info("holds not picked up expired", zap.Int("count", expired))
+	}
+}
@@ -0,0 +1,42 @@
+package holdexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/project/library/generated/mocks"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestHoldExpiry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		expireError error
+	}{
+		{
+			name: "expiry without errors",
+		},
+		{
+			name:        "expiry with use case errors",
+			expireError: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			expirer := mocks.NewMockHoldExpiryUseCase(ctrl)
+			expirer.EXPECT().ExpireHolds(ctx).Return(1, tc.expireError)
+
+			New(zap.NewNop(), expirer).expire(ctx)
+		})
+	}
+}
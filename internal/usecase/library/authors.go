@@ -6,8 +6,9 @@ import (
 
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/usecase/repository"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/project/library/internal/entity"
 )
@@ -20,7 +21,12 @@ func (l *libraryImpl) RegisterAuthor(ctx context.Context, request *library.Regis
 
 		var txErr error
 		author, txErr = l.authorRepository.RegisterAuthor(ctx, entity.Author{
-			Name: request.GetName(),
+			Name:        request.GetName(),
+			BirthDate:   timeFromProto(request.GetBirthDate()),
+			DeathDate:   timeFromProto(request.GetDeathDate()),
+			Nationality: request.GetNationality(),
+			Biography:   request.GetBiography(),
+			Aliases:     uniqueAliases(request.GetAliases()),
 		})
 
 		if txErr != nil {
@@ -51,14 +57,60 @@ func (l *libraryImpl) RegisterAuthor(ctx context.Context, request *library.Regis
 }
 
 func (l *libraryImpl) ChangeAuthorInfo(ctx context.Context, request *library.ChangeAuthorInfoRequest) (*library.ChangeAuthorInfoResponse, error) {
+	// An empty mask replaces the name only, the profile was added later.
+	paths, err := getMaskPathsOrDefault(request.GetUpdateMask(), []string{"name"}, "name", "birth_date", "death_date",
+		"nationality", "biography", "aliases")
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	version, err := parseEtag(request.GetEtag())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	update := entity.AuthorUpdate{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	}
+
+	// Unset dates are zero values, which clear the stored ones.
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.Name = lo.ToPtr(request.GetName())
+		case "birth_date":
+			update.BirthDate = lo.ToPtr(lo.FromPtr(timeFromProto(request.GetBirthDate())))
+		case "death_date":
+			update.DeathDate = lo.ToPtr(lo.FromPtr(timeFromProto(request.GetDeathDate())))
+		case "nationality":
+			update.Nationality = lo.ToPtr(request.GetNationality())
+		case "biography":
+			update.Biography = lo.ToPtr(request.GetBiography())
+		case "aliases":
+			update.Aliases = lo.ToPtr(uniqueAliases(request.GetAliases()))
+		}
+	}
+
 	l.logger.Info("Change author info request is being made to the database.")
-	_, err := l.authorRepository.ChangeAuthorInfo(ctx, request.GetId(), request.GetName())
+	author, err := l.authorRepository.ChangeAuthorInfo(ctx, update)
 
 	if err != nil {
 		return nil, l.convertErr(err)
 	}
 
-	return &library.ChangeAuthorInfoResponse{}, err
+	return &library.ChangeAuthorInfoResponse{
+		Id:          author.ID,
+		Name:        author.Name,
+		Etag:        formatEtag(author.Version),
+		BirthDate:   timeToProto(author.BirthDate),
+		DeathDate:   timeToProto(author.DeathDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     author.Aliases,
+	}, nil
 }
 
 func (l *libraryImpl) GetAuthorInfo(ctx context.Context, request *library.GetAuthorInfoRequest) (*library.GetAuthorInfoResponse, error) {
@@ -70,31 +122,348 @@ func (l *libraryImpl) GetAuthorInfo(ctx context.Context, request *library.GetAut
 	}
 
 	return &library.GetAuthorInfoResponse{
-		Id:   author.ID,
-		Name: author.Name,
+		Id:          author.ID,
+		Name:        author.Name,
+		Etag:        formatEtag(author.Version),
+		BirthDate:   timeToProto(author.BirthDate),
+		DeathDate:   timeToProto(author.DeathDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     author.Aliases,
 	}, nil
 }
 
+func (l *libraryImpl) BatchGetAuthors(ctx context.Context, request *library.BatchGetAuthorsRequest) (*library.BatchGetAuthorsResponse, error) {
+	l.logger.Info("Batch get authors request is being made to the database.")
+	ids := normalizeIDs(request.GetIds())
+	authors, err := l.authorRepository.GetAuthorsInfo(ctx, ids)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.BatchGetAuthorsResponse{
+		Authors:    make([]*library.Author, 0, len(authors)),
+		MissingIds: make([]string, 0),
+	}
+
+	for _, id := range ids {
+		if author, ok := authors[id]; ok {
+			response.Authors = append(response.Authors, authorToProto(author))
+		} else {
+			response.MissingIds = append(response.MissingIds, id)
+		}
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) ListAuthors(ctx context.Context, request *library.ListAuthorsRequest) (*library.ListAuthorsResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	params := entity.ListAuthorsParams{
+		Filter: entity.AuthorFilter{
+			Name:            request.GetName(),
+			NameMatch:       entity.AuthorNameMatchPrefix,
+			CaseInsensitive: request.GetCaseInsensitive(),
+		},
+		Limit: pageSize + 1,
+	}
+
+	if request.GetNameMatch() == library.AuthorNameMatch_AUTHOR_NAME_MATCH_EXACT {
+		params.Filter.NameMatch = entity.AuthorNameMatchExact
+	}
+
+	if request.GetPageToken() != "" {
+		var token authorPageToken
+
+		if err := decodePageToken(request.GetPageToken(), params.Filter, &token); err != nil {
+			return nil, l.convertErr(err)
+		}
+
+		params.After = &entity.AuthorCursor{
+			ID:   token.ID,
+			Name: token.Name,
+		}
+	}
+
+	l.logger.Info("List authors request is being made to the database.")
+	authors, err := l.authorRepository.ListAuthors(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListAuthorsResponse{}
+
+	if len(authors) > pageSize {
+		authors = authors[:pageSize]
+		last := authors[pageSize-1]
+
+		response.NextPageToken, err = encodePageToken(params.Filter, authorPageToken{
+			ID:   last.ID,
+			Name: last.Name,
+		})
+
+		if err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	response.Authors = make([]*library.Author, 0, len(authors))
+	for _, author := range authors {
+		response.Authors = append(response.Authors, authorToProto(author))
+	}
+
+	return response, nil
+}
+
+// nextPageTokenKey is the trailer carrying the next page token of GetAuthorBooks.
+const nextPageTokenKey = "next-page-token"
+
 func (l *libraryImpl) GetAuthorBooks(ctx context.Context, request *library.GetAuthorBooksRequest, resp library.Library_GetAuthorBooksServer) error {
+	pageSize := int(request.GetPageSize())
+
+	params := entity.ListBooksParams{
+		Filter: entity.BookFilter{
+			AuthorID:   request.GetAuthorId(),
+			AuthorRole: entity.ContributorRole(request.GetRole()),
+		},
+		OrderBy:    bookOrderFromProto(request.GetOrderBy()),
+		Descending: request.GetDescending(),
+	}
+
+	if pageSize > 0 {
+		params.Limit = pageSize + 1
+	}
+
+	if request.GetPageToken() != "" {
+		if err := setBookPageToken(request.GetPageToken(), &params); err != nil {
+			return l.convertErr(err)
+		}
+	}
+
+	var (
+		sent    int
+		last    entity.Book
+		hasMore bool
+		sendErr error
+	)
+
 	l.logger.Info("Get author books request is being made to the database.")
-	books, err := l.authorRepository.GetAuthorBooks(ctx, request.GetAuthorId())
+	err := l.authorRepository.GetAuthorBooks(ctx, params, func(book entity.Book) error {
+		if pageSize > 0 && sent == pageSize {
+			hasMore = true
+			return nil
+		}
+
+		if sendErr = resp.Send(bookToProto(book)); sendErr != nil {
+			return sendErr
+		}
+
+		sent++
+		last = book
+
+		return nil
+	})
+
+	if sendErr != nil {
+		l.logger.Error("Error while sending author books.", zap.Error(sendErr))
+		return sendErr
+	}
 
 	if err != nil {
 		return l.convertErr(err)
 	}
 
-	for _, book := range books {
-		err = resp.Send(&library.Book{
-			Id:        book.ID,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIDs,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
-		})
+	if hasMore {
+		token, err := getBookPageToken(params, last)
+
 		if err != nil {
-			l.logger.Error("error while sending response", zap.Error(err))
+			return l.convertErr(err)
 		}
+
+		resp.SetTrailer(metadata.Pairs(nextPageTokenKey, token))
 	}
 
 	return nil
 }
+
+func (l *libraryImpl) DeleteAuthor(ctx context.Context, request *library.DeleteAuthorRequest) (*library.DeleteAuthorResponse, error) {
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Delete author request is being made to the database.")
+
+		author, txErr := l.authorRepository.DeleteAuthor(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		serialized, txErr := json.Marshal(author)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := versionedIdempotencyKey(repository.OutboxKindAuthorDeleted, author.ID, author.Version)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindAuthorDeleted, serialized)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.DeleteAuthorResponse{}, nil
+}
+
+func (l *libraryImpl) RestoreAuthor(ctx context.Context, request *library.RestoreAuthorRequest) (*library.RestoreAuthorResponse, error) {
+	var author entity.Author
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Restore author request is being made to the database.")
+
+		var txErr error
+		author, txErr = l.authorRepository.RestoreAuthor(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		serialized, txErr := json.Marshal(author)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := versionedIdempotencyKey(repository.OutboxKindAuthor, author.ID, author.Version)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindAuthor, serialized)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RestoreAuthorResponse{
+		Id:   author.ID,
+		Name: author.Name,
+		Etag: formatEtag(author.Version),
+	}, nil
+}
+
+// defaultDuplicateSimilarity is the trigram similarity of the names of duplicate candidates when none is requested.
+const defaultDuplicateSimilarity = 0.5
+
+func (l *libraryImpl) FindDuplicateAuthors(ctx context.Context, request *library.FindDuplicateAuthorsRequest) (*library.FindDuplicateAuthorsResponse, error) {
+	params := entity.FindDuplicateAuthorsParams{
+		AuthorID:      request.GetAuthorId(),
+		MinSimilarity: request.GetMinSimilarity(),
+		Limit:         getPageSize(request.GetPageSize()),
+	}
+
+	if params.MinSimilarity == 0 {
+		params.MinSimilarity = defaultDuplicateSimilarity
+	}
+
+	l.logger.Info("Find duplicate authors request is being made to the database.")
+	duplicates, err := l.authorRepository.FindDuplicateAuthors(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.FindDuplicateAuthorsResponse{
+		Duplicates: make([]*library.DuplicateAuthors, 0, len(duplicates)),
+	}
+
+	for _, pair := range duplicates {
+		response.Duplicates = append(response.Duplicates, &library.DuplicateAuthors{
+			AuthorId:      pair.AuthorID,
+			AuthorName:    pair.AuthorName,
+			DuplicateId:   pair.DuplicateID,
+			DuplicateName: pair.DuplicateName,
+			Similarity:    pair.Similarity,
+		})
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) MergeAuthors(ctx context.Context, request *library.MergeAuthorsRequest) (*library.MergeAuthorsResponse, error) {
+	sourceIDs := normalizeIDs(request.GetSourceIds())
+	targetID := normalizeIDs([]string{request.GetTargetId()})[0]
+
+	if lo.Contains(sourceIDs, targetID) {
+		return nil, l.convertErr(entity.ErrAuthorSelfMerge)
+	}
+
+	var merge entity.AuthorMerge
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Merge authors request is being made to the database.")
+
+		var txErr error
+		merge, txErr = l.authorRepository.MergeAuthors(ctx, sourceIDs, targetID)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		// Subscribers see the sources removed, the target and the editions of its new works changed.
+		for _, source := range merge.Sources {
+			if txErr = l.sendAuthorMessage(ctx, repository.OutboxKindAuthorDeleted, source); txErr != nil {
+				return txErr
+			}
+		}
+
+		if txErr = l.sendAuthorMessage(ctx, repository.OutboxKindAuthor, merge.Target); txErr != nil {
+			return txErr
+		}
+
+		messages := make([]repository.OutboxData, 0, len(merge.Books))
+		for _, book := range merge.Books {
+			serialized, err := json.Marshal(book)
+			if err != nil {
+				return err
+			}
+
+			messages = append(messages, repository.OutboxData{
+				IdempotencyKey: versionedIdempotencyKey(repository.OutboxKindBook, book.ID, book.Version),
+				Kind:           repository.OutboxKindBook,
+				RawData:        serialized,
+			})
+		}
+
+		return l.outboxRepository.SendMessages(ctx, messages)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.MergeAuthorsResponse{
+		Author: authorToProto(merge.Target),
+	}, nil
+}
+
+func (l *libraryImpl) sendAuthorMessage(ctx context.Context, kind repository.OutboxKind, author entity.Author) error {
+	serialized, err := json.Marshal(author)
+
+	if err != nil {
+		return err
+	}
+
+	idempotencyKey := versionedIdempotencyKey(kind, author.ID, author.Version)
+	return l.outboxRepository.SendMessage(ctx, idempotencyKey, kind, serialized)
+}
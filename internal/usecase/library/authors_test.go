@@ -2,8 +2,9 @@ package library
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"math/rand/v2"
+	"strconv"
 	"testing"
 	"time"
 
@@ -12,11 +13,15 @@ import (
 	"github.com/project/library/generated/mocks"
 	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/repository"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func getDefaultAuthorUseCaseWithOutbox(
@@ -28,7 +33,12 @@ func getDefaultAuthorUseCaseWithOutbox(
 	booksRepo := mocks.NewMockBooksRepository(ctrl)
 	logger := zap.NewNop()
 
-	return New(logger, transactor, outboxRepository, authorsRepository, booksRepo)
+	return New(logger, transactor, outboxRepository, authorsRepository, booksRepo,
+		mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl), mocks.NewMockGenreRepository(ctrl),
+		mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+		mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl), mocks.NewMockHoldRepository(ctrl),
+		mocks.NewMockFineRepository(ctrl), mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl),
+		mocks.NewMockReviewRepository(ctrl))
 }
 
 func getDefaultAuthorUseCase(ctrl *gomock.Controller, authorsRepository *mocks.MockAuthorRepository) *libraryImpl {
@@ -130,9 +140,13 @@ func TestRegisterAuthor(t *testing.T) {
 func TestChangeAuthorInfo(t *testing.T) {
 	t.Parallel()
 
+	id := uuid.NewString()
+	name := "test"
+
 	testCases := []struct {
 		name             string
 		request          *library.ChangeAuthorInfoRequest
+		expectedVersion  int64
 		expectedResponse *library.ChangeAuthorInfoResponse
 		repositoryError  error
 		expectedError    error
@@ -140,18 +154,70 @@ func TestChangeAuthorInfo(t *testing.T) {
 		{
 			name: "Run without errors",
 			request: &library.ChangeAuthorInfoRequest{
-				Id:   uuid.NewString(),
-				Name: "test",
+				Id:   id,
+				Name: name,
 			},
-			expectedResponse: &library.ChangeAuthorInfoResponse{},
+			expectedResponse: &library.ChangeAuthorInfoResponse{Id: id, Name: name, Etag: "2"},
 			repositoryError:  nil,
 			expectedError:    nil,
 		},
+		{
+			name: "Run with name mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         id,
+				Name:       name,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			},
+			expectedResponse: &library.ChangeAuthorInfoResponse{Id: id, Name: name, Etag: "2"},
+			repositoryError:  nil,
+			expectedError:    nil,
+		},
+		{
+			name: "Run with quoted etag",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:   id,
+				Name: name,
+				Etag: `"1"`,
+			},
+			expectedVersion:  1,
+			expectedResponse: &library.ChangeAuthorInfoResponse{Id: id, Name: name, Etag: "2"},
+			repositoryError:  nil,
+			expectedError:    nil,
+		},
+		{
+			name: "Run with unsupported mask path",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         id,
+				Name:       name,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"books"}},
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid update mask"),
+		},
+		{
+			name: "Run with invalid etag",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:   id,
+				Name: name,
+				Etag: "first",
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid etag"),
+		},
+		{
+			name: "Run with version mismatch",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:   id,
+				Name: name,
+				Etag: "3",
+			},
+			expectedVersion: 3,
+			repositoryError: entity.ErrVersionMismatch,
+			expectedError:   status.Error(codes.FailedPrecondition, "version mismatch"),
+		},
 		{
 			name: "Run with internal errors",
 			request: &library.ChangeAuthorInfoRequest{
-				Id:   uuid.NewString(),
-				Name: "test",
+				Id:   id,
+				Name: name,
 			},
 			expectedResponse: &library.ChangeAuthorInfoResponse{},
 			repositoryError:  errors.New("test error"),
@@ -160,8 +226,8 @@ func TestChangeAuthorInfo(t *testing.T) {
 		{
 			name: "Run with not found errors",
 			request: &library.ChangeAuthorInfoRequest{
-				Id:   uuid.NewString(),
-				Name: "test",
+				Id:   id,
+				Name: name,
 			},
 			expectedResponse: &library.ChangeAuthorInfoResponse{},
 			repositoryError:  entity.ErrAuthorNotFound,
@@ -176,21 +242,138 @@ func TestChangeAuthorInfo(t *testing.T) {
 
 			ctx := context.Background()
 			repo := mocks.NewMockAuthorRepository(ctrl)
-			repo.EXPECT().ChangeAuthorInfo(ctx, tc.request.GetId(), tc.request.GetName()).
-				Return(entity.Author{}, tc.repositoryError).AnyTimes()
+			repo.EXPECT().ChangeAuthorInfo(ctx, entity.AuthorUpdate{ID: id, Name: &name, ExpectedVersion: tc.expectedVersion}).
+				Return(entity.Author{ID: id, Name: name, Version: 2}, tc.repositoryError).AnyTimes()
 			uc := getDefaultAuthorUseCase(ctrl, repo)
 
-			_, err := uc.ChangeAuthorInfo(ctx, tc.request)
+			resp, err := uc.ChangeAuthorInfo(ctx, tc.request)
 			s, ok := status.FromError(err)
 			expS, expOk := status.FromError(tc.expectedError)
 			require.Equal(t, expOk, ok)
-			if ok {
+			if tc.expectedError != nil {
 				require.Equal(t, s.Code(), expS.Code())
+			} else {
+				require.Equal(t, tc.expectedResponse, resp)
 			}
 		})
 	}
 }
 
+func TestRegisterAuthorWithProfile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+	birthDate := time.Date(1835, time.November, 30, 0, 0, 0, 0, time.UTC)
+	author := entity.Author{
+		Name:        "Mark Twain",
+		BirthDate:   &birthDate,
+		Nationality: "US",
+		Biography:   "Writer",
+		Aliases:     []string{"Samuel Clemens"},
+	}
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	authorRepo.EXPECT().RegisterAuthor(ctx, author).Return(entity.Author{ID: uuid.NewString()}, nil)
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthor, gomock.Any()).Return(nil)
+
+	uc := getDefaultAuthorUseCaseWithOutbox(ctrl, authorRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+	_, err := uc.RegisterAuthor(ctx, &library.RegisterAuthorRequest{
+		Name:        author.Name,
+		BirthDate:   timestamppb.New(birthDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     []string{"Samuel Clemens", "Samuel Clemens"},
+	})
+	require.NoError(t, err)
+}
+
+func TestChangeAuthorProfile(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+	deathDate := time.Date(1910, time.April, 21, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		request         *library.ChangeAuthorInfoRequest
+		expectedUpdate  entity.AuthorUpdate
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run with profile paths",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:          id,
+				DeathDate:   timestamppb.New(deathDate),
+				Nationality: "US",
+				Aliases:     []string{"Samuel Clemens"},
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"death_date", "nationality", "aliases"}},
+			},
+			expectedUpdate: entity.AuthorUpdate{
+				ID:          id,
+				DeathDate:   &deathDate,
+				Nationality: lo.ToPtr("US"),
+				Aliases:     &[]string{"Samuel Clemens"},
+			},
+		},
+		{
+			name: "Run with cleared profile",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"birth_date", "biography", "aliases"}},
+			},
+			expectedUpdate: entity.AuthorUpdate{
+				ID:        id,
+				BirthDate: &time.Time{},
+				Biography: lo.ToPtr(""),
+				Aliases:   new([]string),
+			},
+		},
+		{
+			name: "Run with life dates errors",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         id,
+				DeathDate:  timestamppb.New(deathDate),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"death_date"}},
+			},
+			expectedUpdate: entity.AuthorUpdate{
+				ID:        id,
+				DeathDate: &deathDate,
+			},
+			repositoryError: entity.ErrAuthorLifeDates,
+			expectedError:   status.Error(codes.InvalidArgument, "death date is before birth date"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			repo := mocks.NewMockAuthorRepository(ctrl)
+			repo.EXPECT().ChangeAuthorInfo(ctx, tc.expectedUpdate).
+				Return(entity.Author{ID: id, Name: "Mark Twain", Version: 2, DeathDate: tc.expectedUpdate.DeathDate},
+					tc.repositoryError)
+			uc := getDefaultAuthorUseCase(ctrl, repo)
+
+			resp, err := uc.ChangeAuthorInfo(ctx, tc.request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, timeToProto(tc.expectedUpdate.DeathDate), resp.GetDeathDate())
+		})
+	}
+}
+
 func TestGetAuthorInfo(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +394,19 @@ func TestGetAuthorInfo(t *testing.T) {
 			repositoryError: nil,
 			expectedError:   nil,
 		},
+		{
+			name:    "Run with profile",
+			request: &library.GetAuthorInfoRequest{Id: "123"},
+			expectedResponse: &library.GetAuthorInfoResponse{
+				Id:          "123",
+				Name:        "Mark Twain",
+				BirthDate:   timestamppb.New(time.Date(1835, time.November, 30, 0, 0, 0, 0, time.UTC)),
+				DeathDate:   timestamppb.New(time.Date(1910, time.April, 21, 0, 0, 0, 0, time.UTC)),
+				Nationality: "US",
+				Biography:   "Writer",
+				Aliases:     []string{"Samuel Clemens"},
+			},
+		},
 		{
 			name:    "Run with internal errors",
 			request: &library.GetAuthorInfoRequest{Id: "123"},
@@ -242,8 +438,13 @@ func TestGetAuthorInfo(t *testing.T) {
 			AuthorRepo := mocks.NewMockAuthorRepository(ctrl)
 			AuthorRepo.EXPECT().GetAuthorInfo(ctx, tc.request.GetId()).
 				Return(entity.Author{
-					ID:   tc.expectedResponse.GetId(),
-					Name: tc.expectedResponse.GetName(),
+					ID:          tc.expectedResponse.GetId(),
+					Name:        tc.expectedResponse.GetName(),
+					BirthDate:   timeFromProto(tc.expectedResponse.GetBirthDate()),
+					DeathDate:   timeFromProto(tc.expectedResponse.GetDeathDate()),
+					Nationality: tc.expectedResponse.GetNationality(),
+					Biography:   tc.expectedResponse.GetBiography(),
+					Aliases:     tc.expectedResponse.GetAliases(),
 				}, tc.repositoryError)
 
 			uc := getDefaultAuthorUseCase(ctrl, AuthorRepo)
@@ -263,78 +464,105 @@ func TestGetAuthorInfo(t *testing.T) {
 func TestGetAuthorBooks(t *testing.T) {
 	t.Parallel()
 
+	authorID := uuid.NewString()
+	books := []entity.Book{
+		{ID: "123", Name: "test", AuthorIDs: []string{authorID}, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "456", Name: "test", AuthorIDs: []string{authorID}, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "789", Name: "test", AuthorIDs: []string{authorID}, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+
 	testCases := []struct {
-		name             string
-		request          *library.GetAuthorBooksRequest
-		expectedResponse []entity.Book
-		repositoryError  error
-		expectedError    error
+		name            string
+		request         *library.GetAuthorBooksRequest
+		expectedParams  entity.ListBooksParams
+		repositoryError error
+		sendError       error
+		expectedSent    int
+		expectedTrailer bool
+		expectedError   error
 	}{
 		{
 			name: "Run without errors",
 			request: &library.GetAuthorBooksRequest{
-				AuthorId: "123",
-			},
-			expectedResponse: []entity.Book{
-				{
-					ID:        "123",
-					Name:      "test",
-					AuthorIDs: []string{"123"},
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				},
-				{
-					ID:        "456",
-					Name:      "test",
-					AuthorIDs: []string{"123"},
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				},
-				{
-					ID:        "789",
-					Name:      "test",
-					AuthorIDs: []string{"123"},
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				},
+				AuthorId: authorID,
 			},
-			repositoryError: nil,
-			expectedError:   nil,
+			expectedParams: entity.ListBooksParams{Filter: entity.BookFilter{AuthorID: authorID}},
+			expectedSent:   3,
+		},
+		{
+			name: "Run with page size",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId:   authorID,
+				PageSize:   2,
+				OrderBy:    library.BookOrderBy_BOOK_ORDER_BY_CREATED_AT,
+				Descending: true,
+			},
+			expectedParams: entity.ListBooksParams{
+				Filter:     entity.BookFilter{AuthorID: authorID},
+				OrderBy:    entity.BookOrderByCreatedAt,
+				Descending: true,
+				Limit:      3,
+			},
+			expectedSent:    2,
+			expectedTrailer: true,
+		},
+		{
+			name: "Run with role",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId: authorID,
+				Role:     library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR,
+			},
+			expectedParams: entity.ListBooksParams{
+				Filter: entity.BookFilter{AuthorID: authorID, AuthorRole: entity.ContributorRoleTranslator},
+			},
+			expectedSent: 3,
+		},
+		{
+			name: "Run with send error",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId: authorID,
+			},
+			expectedParams: entity.ListBooksParams{Filter: entity.BookFilter{AuthorID: authorID}},
+			sendError:      status.Error(codes.Unavailable, "send error"),
+			expectedSent:   1,
+			expectedError:  status.Error(codes.Unavailable, "send error"),
+		},
+		{
+			name: "Run with invalid page token",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId:  authorID,
+				PageToken: "invalid",
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
 		},
 		{
 			name: "Run with internal errors",
 			request: &library.GetAuthorBooksRequest{
-				AuthorId: "123",
-			},
-			expectedResponse: []entity.Book{
-				{
-					ID:        "432",
-					Name:      "test",
-					AuthorIDs: []string{"123"},
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				},
+				AuthorId: authorID,
 			},
+			expectedParams:  entity.ListBooksParams{Filter: entity.BookFilter{AuthorID: authorID}},
 			repositoryError: errors.New("test error"),
+			expectedSent:    3,
 			expectedError:   status.Error(codes.Internal, "repository error"),
 		},
 		{
 			name: "Run with not found errors",
 			request: &library.GetAuthorBooksRequest{
-				AuthorId: "123",
-			},
-			expectedResponse: []entity.Book{
-				{
-					ID:        "432",
-					Name:      "test",
-					AuthorIDs: []string{"123"},
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				},
+				AuthorId: authorID,
 			},
+			expectedParams:  entity.ListBooksParams{Filter: entity.BookFilter{AuthorID: authorID}},
 			repositoryError: entity.ErrAuthorNotFound,
 			expectedError:   status.Error(codes.NotFound, "author not found"),
 		},
+		{
+			name: "Run with last page",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId: authorID,
+				PageSize: 3,
+			},
+			expectedParams: entity.ListBooksParams{Filter: entity.BookFilter{AuthorID: authorID}, Limit: 4},
+			expectedSent:   3,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -344,44 +572,535 @@ func TestGetAuthorBooks(t *testing.T) {
 
 			ctx := context.Background()
 
-			books := make([]*library.Book, 0)
+			sent := make([]*library.Book, 0)
 			server := mocks.NewMockGetAuthorBooksServer(ctrl)
 			server.EXPECT().Send(gomock.Any()).DoAndReturn(func(book *library.Book) error {
-				books = append(books, book)
-				if rand.Int()%2 == 1 {
-					return errors.New("append error")
-				}
-				return nil
+				sent = append(sent, book)
+				return tc.sendError
+			}).AnyTimes()
+
+			trailers := 0
+			server.EXPECT().SetTrailer(gomock.Any()).Do(func(md metadata.MD) {
+				require.Len(t, md.Get(nextPageTokenKey), 1)
+				trailers++
 			}).AnyTimes()
 
 			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().GetAuthorBooks(ctx, tc.expectedParams, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ entity.ListBooksParams, handle func(entity.Book) error) error {
+					// The existence of the author is checked before any book is read.
+					if errors.Is(tc.repositoryError, entity.ErrAuthorNotFound) {
+						return tc.repositoryError
+					}
 
-			entityBooks := make([]entity.Book, 0)
-			for _, book := range tc.expectedResponse {
-				entityBooks = append(entityBooks,
-					entity.Book{
-						ID:        book.ID,
-						Name:      book.Name,
-						AuthorIDs: book.AuthorIDs,
-						CreatedAt: book.CreatedAt,
-						UpdatedAt: book.UpdatedAt,
-					},
-				)
+					for _, book := range books {
+						if err := handle(book); err != nil {
+							return err
+						}
+					}
+					return tc.repositoryError
+				},
+			).MaxTimes(1)
+
+			uc := getDefaultAuthorUseCase(ctrl, authorRepo)
+			err := uc.GetAuthorBooks(ctx, tc.request, server)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if tc.expectedError != nil {
+				require.Equal(t, expS.Code(), s.Code())
+			}
+
+			require.Len(t, sent, tc.expectedSent)
+			require.Equal(t, tc.expectedTrailer, trailers == 1)
+		})
+	}
+}
+
+func TestDeleteAuthor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		request         *library.DeleteAuthorRequest
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name:          "Run without errors",
+			request:       &library.DeleteAuthorRequest{Id: "123"},
+			expectedError: nil,
+		},
+		{
+			name:            "Run with not found errors",
+			request:         &library.DeleteAuthorRequest{Id: "123"},
+			repositoryError: entity.ErrAuthorNotFound,
+			expectedError:   status.Error(codes.NotFound, "author not found"),
+		},
+		{
+			name:          "Run with outbox errors",
+			request:       &library.DeleteAuthorRequest{Id: "123"},
+			outboxError:   errors.New("test"),
+			expectedError: status.Error(codes.Internal, "repository error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			deletedAt := time.Now()
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().DeleteAuthor(ctx, tc.request.GetId()).
+				Return(entity.Author{ID: tc.request.GetId(), DeletedAt: &deletedAt}, tc.repositoryError)
+
+			transactor := mocks.NewMockTransactor(ctrl)
+			transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				},
+			)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
 			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthorDeleted, gomock.Any()).
+				Return(tc.outboxError).Times(times)
 
-			authorRepo.EXPECT().GetAuthorBooks(ctx, tc.request.GetAuthorId()).
-				Return(entityBooks, tc.repositoryError)
+			uc := getDefaultAuthorUseCaseWithOutbox(ctrl, authorRepo, transactor, outboxRepo)
+			_, err := uc.DeleteAuthor(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			}
+		})
+	}
+}
+
+func TestRestoreAuthor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RestoreAuthorRequest
+		expectedResponse *library.RestoreAuthorResponse
+		repositoryError  error
+		expectedError    error
+	}{
+		{
+			name:    "Run without errors",
+			request: &library.RestoreAuthorRequest{Id: "123"},
+			expectedResponse: &library.RestoreAuthorResponse{
+				Id:   "123",
+				Name: "Test",
+			},
+			repositoryError: nil,
+			expectedError:   nil,
+		},
+		{
+			name:             "Run with not found errors",
+			request:          &library.RestoreAuthorRequest{Id: "123"},
+			expectedResponse: &library.RestoreAuthorResponse{},
+			repositoryError:  entity.ErrAuthorNotFound,
+			expectedError:    status.Error(codes.NotFound, "author not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().RestoreAuthor(ctx, tc.request.GetId()).
+				Return(entity.Author{
+					ID:   tc.expectedResponse.GetId(),
+					Name: tc.expectedResponse.GetName(),
+				}, tc.repositoryError)
+
+			transactor := mocks.NewMockTransactor(ctrl)
+			transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				},
+			)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthor, gomock.Any()).
+				Return(nil).Times(times)
+
+			uc := getDefaultAuthorUseCaseWithOutbox(ctrl, authorRepo, transactor, outboxRepo)
+			resp, err := uc.RestoreAuthor(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, tc.expectedResponse, resp)
+			}
+		})
+	}
+}
+
+func TestListAuthors(t *testing.T) {
+	t.Parallel()
+
+	authors := []entity.Author{
+		{ID: uuid.NewString(), Name: "Leo Tolstoy"},
+		{ID: uuid.NewString(), Name: "Leonid Andreyev"},
+	}
+
+	validToken, err := encodePageToken(
+		entity.AuthorFilter{Name: "leo tolstoy", NameMatch: entity.AuthorNameMatchExact, CaseInsensitive: true},
+		authorPageToken{ID: authors[0].ID, Name: authors[0].Name},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		request           *library.ListAuthorsRequest
+		repositoryAuthors []entity.Author
+		repositoryError   error
+		expectedParams    entity.ListAuthorsParams
+		expectedAuthors   int
+		expectedNextToken bool
+		expectedError     error
+	}{
+		{
+			name:              "Run with prefix match",
+			request:           &library.ListAuthorsRequest{PageSize: 1, Name: "Leo"},
+			repositoryAuthors: authors,
+			expectedParams: entity.ListAuthorsParams{
+				Filter: entity.AuthorFilter{Name: "Leo", NameMatch: entity.AuthorNameMatchPrefix},
+				Limit:  2,
+			},
+			expectedAuthors:   1,
+			expectedNextToken: true,
+		},
+		{
+			name: "Run with case insensitive exact match",
+			request: &library.ListAuthorsRequest{
+				Name:            "leo tolstoy",
+				NameMatch:       library.AuthorNameMatch_AUTHOR_NAME_MATCH_EXACT,
+				CaseInsensitive: true,
+				PageToken:       validToken,
+			},
+			repositoryAuthors: authors[:1],
+			expectedParams: entity.ListAuthorsParams{
+				Filter: entity.AuthorFilter{
+					Name:            "leo tolstoy",
+					NameMatch:       entity.AuthorNameMatchExact,
+					CaseInsensitive: true,
+				},
+				After: &entity.AuthorCursor{ID: authors[0].ID, Name: authors[0].Name},
+				Limit: defaultPageSize + 1,
+			},
+			expectedAuthors: 1,
+		},
+		{
+			name:          "Run with malformed page token",
+			request:       &library.ListAuthorsRequest{PageToken: "???"},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:          "Run with page token of another filter",
+			request:       &library.ListAuthorsRequest{Name: "leo", PageToken: validToken},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:            "Run with internal errors",
+			request:         &library.ListAuthorsRequest{},
+			repositoryError: errors.New("test error"),
+			expectedParams:  entity.ListAuthorsParams{Limit: defaultPageSize + 1},
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().ListAuthors(ctx, tc.expectedParams).
+				Return(tc.repositoryAuthors, tc.repositoryError).MaxTimes(1)
 
 			uc := getDefaultAuthorUseCase(ctrl, authorRepo)
-			err := uc.GetAuthorBooks(ctx, tc.request, server)
+			resp, err := uc.ListAuthors(ctx, tc.request)
 			s, ok := status.FromError(err)
 			expS, expOk := status.FromError(tc.expectedError)
 			require.Equal(t, expOk, ok)
 			if ok {
 				require.Equal(t, expS.Code(), s.Code())
 			} else {
-				require.Equal(t, tc.expectedResponse, books)
+				require.Len(t, resp.GetAuthors(), tc.expectedAuthors)
+				require.Equal(t, tc.expectedNextToken, resp.GetNextPageToken() != "")
+			}
+		})
+	}
+}
+
+func TestBatchGetAuthors(t *testing.T) {
+	t.Parallel()
+
+	found := uuid.NewString()
+	merged := uuid.NewString()
+	missing := uuid.NewString()
+
+	testCases := []struct {
+		name              string
+		request           *library.BatchGetAuthorsRequest
+		repositoryAuthors map[string]entity.Author
+		repositoryError   error
+		expectedResponse  *library.BatchGetAuthorsResponse
+		expectedError     error
+	}{
+		{
+			name: "Run without errors",
+			request: &library.BatchGetAuthorsRequest{
+				Ids: []string{found, missing, found},
+			},
+			repositoryAuthors: map[string]entity.Author{found: {ID: found, Name: "Test", Version: 1}},
+			expectedResponse: &library.BatchGetAuthorsResponse{
+				Authors:    []*library.Author{{Id: found, Name: "Test", Etag: "1"}},
+				MissingIds: []string{missing},
+			},
+		},
+		{
+			name: "Run with merged author",
+			request: &library.BatchGetAuthorsRequest{
+				Ids: []string{merged, found},
+			},
+			repositoryAuthors: map[string]entity.Author{
+				merged: {ID: found, Name: "Test", Version: 1},
+				found:  {ID: found, Name: "Test", Version: 1},
+			},
+			expectedResponse: &library.BatchGetAuthorsResponse{
+				Authors:    []*library.Author{{Id: found, Name: "Test", Etag: "1"}, {Id: found, Name: "Test", Etag: "1"}},
+				MissingIds: []string{},
+			},
+		},
+		{
+			name: "Run with internal error",
+			request: &library.BatchGetAuthorsRequest{
+				Ids: []string{found},
+			},
+			repositoryError: errors.New("repository error"),
+			expectedError:   status.Error(codes.Internal, "repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().GetAuthorsInfo(ctx, normalizeIDs(tc.request.GetIds())).
+				Return(tc.repositoryAuthors, tc.repositoryError)
+
+			uc := getDefaultAuthorUseCase(ctrl, authorRepo)
+			resp, err := uc.BatchGetAuthors(ctx, tc.request)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if tc.expectedError != nil {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, tc.expectedResponse, resp)
+			}
+		})
+	}
+}
+
+func TestFindDuplicateAuthors(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.NewString()
+	duplicates := []entity.DuplicateAuthors{
+		{AuthorID: authorID, AuthorName: "Mark Twain", DuplicateID: uuid.NewString(), DuplicateName: "Mark Twen",
+			Similarity: 0.6},
+	}
+
+	testCases := []struct {
+		name            string
+		request         *library.FindDuplicateAuthorsRequest
+		expectedParams  entity.FindDuplicateAuthorsParams
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name:    "Run with default similarity",
+			request: &library.FindDuplicateAuthorsRequest{},
+			expectedParams: entity.FindDuplicateAuthorsParams{
+				MinSimilarity: defaultDuplicateSimilarity,
+				Limit:         defaultPageSize,
+			},
+		},
+		{
+			name:    "Run with author",
+			request: &library.FindDuplicateAuthorsRequest{AuthorId: authorID, MinSimilarity: 0.8, PageSize: 10},
+			expectedParams: entity.FindDuplicateAuthorsParams{
+				AuthorID:      authorID,
+				MinSimilarity: 0.8,
+				Limit:         10,
+			},
+		},
+		{
+			name:    "Run with internal errors",
+			request: &library.FindDuplicateAuthorsRequest{},
+			expectedParams: entity.FindDuplicateAuthorsParams{
+				MinSimilarity: defaultDuplicateSimilarity,
+				Limit:         defaultPageSize,
+			},
+			repositoryError: errors.New("test error"),
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			repo := mocks.NewMockAuthorRepository(ctrl)
+			repo.EXPECT().FindDuplicateAuthors(ctx, tc.expectedParams).Return(duplicates, tc.repositoryError)
+
+			uc := getDefaultAuthorUseCase(ctrl, repo)
+			resp, err := uc.FindDuplicateAuthors(ctx, tc.request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
 			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetDuplicates(), 1)
+			require.Equal(t, duplicates[0].DuplicateName, resp.GetDuplicates()[0].GetDuplicateName())
+			require.InDelta(t, duplicates[0].Similarity, resp.GetDuplicates()[0].GetSimilarity(), 0)
+		})
+	}
+}
+
+func TestMergeAuthors(t *testing.T) {
+	t.Parallel()
+
+	targetID := uuid.NewString()
+	sourceIDs := []string{uuid.NewString(), uuid.NewString()}
+	merge := entity.AuthorMerge{
+		Target: entity.Author{ID: targetID, Name: "Mark Twain", Version: 2, Aliases: []string{"Samuel Clemens"}},
+		Sources: []entity.Author{
+			{ID: sourceIDs[0], Name: "Samuel Clemens"},
+			{ID: sourceIDs[1], Name: "Mark Twain"},
+		},
+		Books: []entity.Book{
+			{ID: uuid.NewString(), Name: "Tom Sawyer", AuthorIDs: []string{targetID}, Version: 3},
+			{ID: uuid.NewString(), Name: "Huckleberry Finn", AuthorIDs: []string{targetID}, Version: 5},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		request         *library.MergeAuthorsRequest
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name:    "Run without errors",
+			request: &library.MergeAuthorsRequest{SourceIds: sourceIDs, TargetId: targetID},
+		},
+		{
+			name: "Run with duplicate sources",
+			request: &library.MergeAuthorsRequest{
+				SourceIds: []string{sourceIDs[0], sourceIDs[1], sourceIDs[0]},
+				TargetId:  targetID,
+			},
+		},
+		{
+			name:          "Run with target among sources",
+			request:       &library.MergeAuthorsRequest{SourceIds: []string{sourceIDs[0], targetID}, TargetId: targetID},
+			expectedError: status.Error(codes.InvalidArgument, "author cannot be merged into itself"),
+		},
+		{
+			name:            "Run with not found errors",
+			request:         &library.MergeAuthorsRequest{SourceIds: sourceIDs, TargetId: targetID},
+			repositoryError: entity.ErrAuthorNotFound,
+			expectedError:   status.Error(codes.NotFound, "author not found"),
+		},
+		{
+			name:          "Run with outbox errors",
+			request:       &library.MergeAuthorsRequest{SourceIds: sourceIDs, TargetId: targetID},
+			outboxError:   errors.New("test error"),
+			expectedError: status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().MergeAuthors(ctx, sourceIDs, targetID).Return(merge, tc.repositoryError).MaxTimes(1)
+
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			deleted := outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthorDeleted, gomock.Any()).
+				Return(tc.outboxError).MaxTimes(len(sourceIDs))
+			changed := outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthor, gomock.Any()).
+				Return(nil).After(deleted).MaxTimes(1)
+			outboxRepo.EXPECT().SendMessages(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, messages []repository.OutboxData) error {
+					require.Len(t, messages, len(merge.Books))
+					for i, message := range messages {
+						book := merge.Books[i]
+						require.Equal(t, repository.OutboxKindBook, message.Kind)
+						require.Equal(t, repository.OutboxKindBook.String()+"_"+book.ID+"_"+
+							strconv.FormatInt(book.Version, 10), message.IdempotencyKey)
+
+						var sent entity.Book
+						require.NoError(t, json.Unmarshal(message.RawData, &sent))
+						require.Equal(t, book.Name, sent.Name)
+						require.Equal(t, book.AuthorIDs, sent.AuthorIDs)
+					}
+					return nil
+				},
+			).After(changed).MaxTimes(1)
+
+			// The target among the sources is rejected before the transaction starts.
+			transactor := mocks.NewMockTransactor(ctrl)
+			if !lo.Contains(tc.request.GetSourceIds(), targetID) {
+				transactor = newPassingTransactor(ctx, ctrl)
+			}
+
+			uc := getDefaultAuthorUseCaseWithOutbox(ctrl, authorRepo, transactor, outboxRepo)
+			resp, err := uc.MergeAuthors(ctx, tc.request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, authorToProto(merge.Target), resp.GetAuthor())
 		})
 	}
 }
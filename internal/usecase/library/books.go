@@ -3,10 +3,15 @@ package library
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/usecase/repository"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/project/library/internal/entity"
 )
@@ -18,10 +23,7 @@ func (l *libraryImpl) AddBook(ctx context.Context, request *library.AddBookReque
 		l.logger.Info("Add book request is being made to the database.")
 
 		var txErr error
-		book, txErr = l.booksRepository.AddBook(ctx, entity.Book{
-			Name:      request.GetName(),
-			AuthorIDs: request.GetAuthorIds(),
-		})
+		book, txErr = l.booksRepository.AddBook(ctx, bookFromRequest(request))
 
 		if txErr != nil {
 			return txErr
@@ -48,25 +50,243 @@ func (l *libraryImpl) AddBook(ctx context.Context, request *library.AddBookReque
 	}
 
 	return &library.AddBookResponse{
-		Book: &library.Book{
-			Id:        book.ID,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIDs,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
-		},
+		Book: bookToProto(book),
 	}, nil
 }
 
+// AddBooks stores the whole chunk in a single transaction. Books referring to unknown
+// authors, publishers or genres are rejected up front, the rest share the outcome of the transaction.
+func (l *libraryImpl) AddBooks(ctx context.Context, requests []*library.AddBookRequest) ([]*library.AddBooksResult, error) {
+	l.logger.Info("Add books request is being made to the database.", zap.Int("count", len(requests)))
+
+	var authorIDs, publisherIDs, genreIDs, workIDs []string
+	for _, request := range requests {
+		authorIDs = append(authorIDs, requestAuthorIDs(request)...)
+		genreIDs = append(genreIDs, request.GetGenreIds()...)
+
+		if request.GetPublisherId() != "" {
+			publisherIDs = append(publisherIDs, request.GetPublisherId())
+		}
+
+		if request.GetWorkId() != "" {
+			workIDs = append(workIDs, request.GetWorkId())
+		}
+	}
+
+	// The ids of merged authors resolve to another author and stay missing, books only name current authors.
+	authors, err := getExisting(ctx, authorIDs, func(ctx context.Context, ids []string) ([]entity.Author, error) {
+		found, err := l.authorRepository.GetAuthorsInfo(ctx, ids)
+		return lo.Values(found), err
+	}, func(author entity.Author) string {
+		return author.ID
+	})
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	publishers, err := getExisting(ctx, publisherIDs, l.publisherRepository.GetPublishersInfo,
+		func(publisher entity.Publisher) string {
+			return publisher.ID
+		})
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	genres, err := getExisting(ctx, genreIDs, l.genreRepository.GetGenresInfo, func(genre entity.Genre) string {
+		return genre.ID
+	})
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	works, err := getExisting(ctx, workIDs, l.workRepository.GetWorksInfo, func(work entity.Work) string {
+		return work.ID
+	})
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	results := make([]*library.AddBooksResult, len(requests))
+	books := make([]entity.Book, 0, len(requests))
+	positions := make([]int, 0, len(requests))
+
+	for i, request := range requests {
+		if err := findMissingReference(request, authors, publishers, genres, works); err != nil {
+			results[i] = &library.AddBooksResult{
+				ErrorCode:    int32(codes.NotFound),
+				ErrorMessage: err.Error(),
+			}
+			continue
+		}
+
+		book := bookFromRequest(request)
+		book.AuthorIDs = lo.Uniq(book.AuthorIDs)
+		book.GenreIDs = lo.Uniq(request.GetGenreIds())
+
+		books = append(books, book)
+		positions = append(positions, i)
+	}
+
+	if len(books) == 0 {
+		return results, nil
+	}
+
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var txErr error
+		books, txErr = l.booksRepository.AddBooks(ctx, books)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		messages := make([]repository.OutboxData, 0, len(books))
+
+		for _, book := range books {
+			serialized, txErr := json.Marshal(book)
+
+			if txErr != nil {
+				return txErr
+			}
+
+			messages = append(messages, repository.OutboxData{
+				IdempotencyKey: repository.OutboxKindBook.String() + "_" + book.ID,
+				Kind:           repository.OutboxKindBook,
+				RawData:        serialized,
+			})
+		}
+
+		return l.outboxRepository.SendMessages(ctx, messages)
+	})
+
+	if err != nil {
+		l.logger.Error("Error while adding books chunk.", zap.Error(err))
+		st := status.Convert(l.convertErr(err))
+
+		for _, position := range positions {
+			results[position] = &library.AddBooksResult{
+				ErrorCode:    int32(st.Code()),
+				ErrorMessage: st.Message(),
+			}
+		}
+
+		return results, nil
+	}
+
+	for i, book := range books {
+		results[positions[i]] = &library.AddBooksResult{
+			Book: bookToProto(book),
+		}
+	}
+
+	return results, nil
+}
+
+// findMissingReference reports the first author, publisher or genre of the request that does not exist.
+func findMissingReference(request *library.AddBookRequest, authors, publishers, genres, works map[string]struct{}) error {
+	isMissing := func(existing map[string]struct{}) func(string) bool {
+		return func(id string) bool {
+			_, ok := existing[id]
+			return !ok
+		}
+	}
+
+	if missing, found := lo.Find(requestAuthorIDs(request), isMissing(authors)); found {
+		return fmt.Errorf("%w: %s", entity.ErrAuthorNotFound, missing)
+	}
+
+	if id := request.GetPublisherId(); id != "" && isMissing(publishers)(id) {
+		return fmt.Errorf("%w: %s", entity.ErrPublisherNotFound, id)
+	}
+
+	if missing, found := lo.Find(request.GetGenreIds(), isMissing(genres)); found {
+		return fmt.Errorf("%w: %s", entity.ErrGenreNotFound, missing)
+	}
+
+	if id := request.GetWorkId(); id != "" && isMissing(works)(id) {
+		return fmt.Errorf("%w: %s", entity.ErrWorkNotFound, id)
+	}
+
+	return nil
+}
+
 func (l *libraryImpl) UpdateBook(ctx context.Context, request *library.UpdateBookRequest) (*library.UpdateBookResponse, error) {
+	// An empty mask replaces the fields the RPC had before the metadata was added.
+	paths, err := getMaskPathsOrDefault(request.GetUpdateMask(), []string{"name", "author_ids"}, "name", "author_ids",
+		"isbn", "publication_year", "language", "page_count", "description", "subtitle", "publisher_id", "genre_ids",
+		"contributors")
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	version, err := parseEtag(request.GetEtag())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	update := entity.BookUpdate{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			name := request.GetName()
+			update.Name = &name
+		case "author_ids":
+			authorIDs := request.GetAuthorIds()
+			update.AuthorIDs = &authorIDs
+		case "isbn":
+			isbn := request.GetIsbn()
+			update.ISBN = &isbn
+		case "publication_year":
+			year := int(request.GetPublicationYear())
+			update.PublicationYear = &year
+		case "language":
+			language := request.GetLanguage()
+			update.Language = &language
+		case "page_count":
+			pageCount := int(request.GetPageCount())
+			update.PageCount = &pageCount
+		case "description":
+			description := request.GetDescription()
+			update.Description = &description
+		case "subtitle":
+			subtitle := request.GetSubtitle()
+			update.Subtitle = &subtitle
+		case "publisher_id":
+			publisherID := request.GetPublisherId()
+			update.PublisherID = &publisherID
+		case "genre_ids":
+			genreIDs := lo.Uniq(request.GetGenreIds())
+			update.GenreIDs = &genreIDs
+		case "contributors":
+			contributors := contributorsFromProto(request.GetContributors())
+			update.Contributors = &contributors
+		}
+	}
+
+	// When both are in the mask the authors are taken from the one that is set.
+	if update.AuthorIDs != nil && update.Contributors != nil {
+		if len(*update.Contributors) == 0 {
+			update.Contributors = nil
+		} else {
+			update.AuthorIDs = nil
+		}
+	}
+
 	l.logger.Info("Update book request is being made to the database.")
-	_, err := l.booksRepository.UpdateBook(ctx, request.GetId(), request.GetName(), request.GetAuthorIds())
+	book, err := l.booksRepository.UpdateBook(ctx, update)
 
 	if err != nil {
 		return nil, l.convertErr(err)
 	}
 
-	return &library.UpdateBookResponse{}, nil
+	return &library.UpdateBookResponse{
+		Book: bookToProto(book),
+	}, nil
 }
 
 func (l *libraryImpl) GetBookInfo(ctx context.Context, request *library.GetBookInfoRequest) (*library.GetBookInfoResponse, error) {
@@ -77,13 +297,199 @@ func (l *libraryImpl) GetBookInfo(ctx context.Context, request *library.GetBookI
 		return nil, l.convertErr(err)
 	}
 
-	return &library.GetBookInfoResponse{
-		Book: &library.Book{
-			Id:        book.ID,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIDs,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
+	response := &library.GetBookInfoResponse{
+		Book: bookToProto(book),
+	}
+
+	series, err := l.seriesRepository.GetBookSeries(ctx, book.ID)
+
+	switch {
+	case errors.Is(err, entity.ErrBookNotInSeries):
+	case err != nil:
+		return nil, l.convertErr(err)
+	default:
+		response.Series = bookSeriesToProto(series)
+	}
+
+	counts, err := l.copyRepository.GetBranchCopyCounts(ctx, book.ID)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response.Branches = make([]*library.BranchAvailability, 0, len(counts))
+	for _, branch := range counts {
+		response.TotalCopies += int32(branch.Total)
+		response.AvailableCopies += int32(branch.Available)
+		response.Branches = append(response.Branches, &library.BranchAvailability{
+			BranchId:        branch.BranchID,
+			BranchName:      branch.BranchName,
+			TotalCopies:     int32(branch.Total),
+			AvailableCopies: int32(branch.Available),
+		})
+	}
+
+	rating, err := l.reviewRepository.GetBookRating(ctx, book.ID)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response.AverageRating = rating.Average()
+	response.ReviewCount = int32(rating.ReviewCount)
+
+	return response, nil
+}
+
+func (l *libraryImpl) GetBookByISBN(ctx context.Context, request *library.GetBookByISBNRequest) (*library.GetBookByISBNResponse, error) {
+	l.logger.Info("Get book by isbn request is being made to the database.")
+	book, err := l.booksRepository.GetBookByISBN(ctx, request.GetIsbn())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.GetBookByISBNResponse{
+		Book: bookToProto(book),
+	}, nil
+}
+
+func (l *libraryImpl) BatchGetBooks(ctx context.Context, request *library.BatchGetBooksRequest) (*library.BatchGetBooksResponse, error) {
+	l.logger.Info("Batch get books request is being made to the database.")
+	ids := normalizeIDs(request.GetIds())
+	books, err := l.booksRepository.GetBooksInfo(ctx, ids)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	found := lo.KeyBy(books, func(book entity.Book) string {
+		return book.ID
+	})
+
+	response := &library.BatchGetBooksResponse{
+		Books:      make([]*library.Book, 0, len(books)),
+		MissingIds: make([]string, 0),
+	}
+
+	for _, id := range ids {
+		if book, ok := found[id]; ok {
+			response.Books = append(response.Books, bookToProto(book))
+		} else {
+			response.MissingIds = append(response.MissingIds, id)
+		}
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) ListBooks(ctx context.Context, request *library.ListBooksRequest) (*library.ListBooksResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	params := entity.ListBooksParams{
+		Filter: entity.BookFilter{
+			NamePrefix:    request.GetNamePrefix(),
+			AuthorID:      request.GetAuthorId(),
+			CreatedAfter:  timeFromProto(request.GetCreatedAfter()),
+			CreatedBefore: timeFromProto(request.GetCreatedBefore()),
+			UpdatedAfter:  timeFromProto(request.GetUpdatedAfter()),
+			UpdatedBefore: timeFromProto(request.GetUpdatedBefore()),
 		},
+		OrderBy:    bookOrderFromProto(request.GetOrderBy()),
+		Descending: request.GetDescending(),
+		Limit:      pageSize + 1,
+	}
+
+	if request.GetPageToken() != "" {
+		if err := setBookPageToken(request.GetPageToken(), &params); err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	l.logger.Info("List books request is being made to the database.")
+	books, err := l.booksRepository.ListBooks(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListBooksResponse{}
+	response.Books, response.NextPageToken, err = getBooksPage(params, pageSize, books)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) DeleteBook(ctx context.Context, request *library.DeleteBookRequest) (*library.DeleteBookResponse, error) {
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Delete book request is being made to the database.")
+
+		book, txErr := l.booksRepository.DeleteBook(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		serialized, txErr := json.Marshal(book)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := versionedIdempotencyKey(repository.OutboxKindBookDeleted, book.ID, book.Version)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindBookDeleted, serialized)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.DeleteBookResponse{}, nil
+}
+
+func (l *libraryImpl) RestoreBook(ctx context.Context, request *library.RestoreBookRequest) (*library.RestoreBookResponse, error) {
+	var book entity.Book
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Restore book request is being made to the database.")
+
+		var txErr error
+		book, txErr = l.booksRepository.RestoreBook(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		serialized, txErr := json.Marshal(book)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := versionedIdempotencyKey(repository.OutboxKindBook, book.ID, book.Version)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindBook, serialized)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RestoreBookResponse{
+		Book: bookToProto(book),
 	}, nil
 }
@@ -3,6 +3,7 @@ package library
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,7 +30,12 @@ func getDefaultBookUseCaseWithOutbox(
 	authorRepo := mocks.NewMockAuthorRepository(ctrl)
 	logger := zap.NewNop()
 
-	return New(logger, transactor, outboxRepository, authorRepo, booksRepository)
+	return New(logger, transactor, outboxRepository, authorRepo, booksRepository,
+		mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl), mocks.NewMockGenreRepository(ctrl),
+		mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+		mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl), mocks.NewMockHoldRepository(ctrl),
+		mocks.NewMockFineRepository(ctrl), mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl),
+		mocks.NewMockReviewRepository(ctrl))
 }
 
 func getDefaultBookUseCase(ctrl *gomock.Controller, booksRepository *mocks.MockBooksRepository) *libraryImpl {
@@ -175,48 +182,343 @@ func TestAddBook(t *testing.T) {
 	}
 }
 
+func TestAddBookWithContributors(t *testing.T) {
+	t.Parallel()
+
+	editorID, authorID := uuid.NewString(), uuid.NewString()
+	contributors := []entity.Contributor{
+		{AuthorID: editorID, Role: entity.ContributorRoleEditor, Position: 1},
+		{AuthorID: authorID, Role: entity.ContributorRoleAuthor, Position: 2},
+	}
+	book := entity.Book{
+		ID:           uuid.NewString(),
+		Name:         "Test",
+		AuthorIDs:    []string{editorID, authorID},
+		Contributors: contributors,
+	}
+
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+	bookRepo := mocks.NewMockBooksRepository(ctrl)
+	bookRepo.EXPECT().AddBook(ctx, entity.Book{
+		Name:         book.Name,
+		AuthorIDs:    book.AuthorIDs,
+		Contributors: contributors,
+	}).Return(book, nil)
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindBook.String()+"_"+book.ID, repository.OutboxKindBook,
+		gomock.Any()).Return(nil)
+
+	uc := getDefaultBookUseCaseWithOutbox(ctrl, bookRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+	resp, err := uc.AddBook(ctx, &library.AddBookRequest{
+		Name: book.Name,
+		Contributors: []*library.Contributor{
+			{AuthorId: editorID, Role: library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR, Position: 5},
+			{AuthorId: authorID},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{editorID, authorID}, resp.GetBook().GetAuthorIds())
+	require.Len(t, resp.GetBook().GetContributors(), 2)
+	require.Equal(t, library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR, resp.GetBook().GetContributors()[0].GetRole())
+	require.Equal(t, int32(2), resp.GetBook().GetContributors()[1].GetPosition())
+}
+
+func TestAddBooks(t *testing.T) {
+	t.Parallel()
+
+	existingAuthor := uuid.NewString()
+	missingAuthor := uuid.NewString()
+	existingPublisher := uuid.NewString()
+	missingPublisher := uuid.NewString()
+	existingGenre := uuid.NewString()
+	missingGenre := uuid.NewString()
+	existingWork := uuid.NewString()
+	missingWork := uuid.NewString()
+
+	requests := []*library.AddBookRequest{
+		{Name: "First", AuthorIds: []string{existingAuthor, existingAuthor}},
+		{Name: "Second", AuthorIds: []string{missingAuthor}},
+		{Name: "Third", PublisherId: existingPublisher},
+		{Name: "Fourth", PublisherId: missingPublisher},
+		{Name: "Fifth", GenreIds: []string{existingGenre, missingGenre}},
+		{Name: "Sixth", WorkId: existingWork},
+		{Name: "Seventh", WorkId: missingWork},
+	}
+
+	testCases := []struct {
+		name          string
+		authorsError  error
+		booksError    error
+		outboxError   error
+		expectedCodes []codes.Code
+		expectedError error
+	}{
+		{
+			name:          "Run without errors",
+			expectedCodes: []codes.Code{codes.OK, codes.NotFound, codes.OK, codes.NotFound, codes.NotFound, codes.OK, codes.NotFound},
+		},
+		{
+			name:          "Run with authors lookup error",
+			authorsError:  errors.New("authors error"),
+			expectedError: status.Error(codes.Internal, "authors error"),
+		},
+		{
+			name:       "Run with repository error",
+			booksError: errors.New("repository error"),
+			expectedCodes: []codes.Code{
+				codes.Internal, codes.NotFound, codes.Internal, codes.NotFound, codes.NotFound, codes.Internal, codes.NotFound,
+			},
+		},
+		{
+			name:        "Run with outbox error",
+			outboxError: errors.New("outbox error"),
+			expectedCodes: []codes.Code{
+				codes.Internal, codes.NotFound, codes.Internal, codes.NotFound, codes.NotFound, codes.Internal, codes.NotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().GetAuthorsInfo(ctx, gomock.InAnyOrder([]string{existingAuthor, missingAuthor})).
+				Return(map[string]entity.Author{existingAuthor: {ID: existingAuthor, Name: "Author"}}, tc.authorsError)
+
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			transactor := mocks.NewMockTransactor(ctrl)
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			publisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			workRepo := mocks.NewMockWorkRepository(ctrl)
+
+			if tc.authorsError == nil {
+				publisherRepo.EXPECT().GetPublishersInfo(ctx, gomock.InAnyOrder([]string{existingPublisher, missingPublisher})).
+					Return([]entity.Publisher{{ID: existingPublisher, Name: "Publisher"}}, nil)
+				genreRepo.EXPECT().GetGenresInfo(ctx, gomock.InAnyOrder([]string{existingGenre, missingGenre})).
+					Return([]entity.Genre{{ID: existingGenre, Name: "Genre"}}, nil)
+				workRepo.EXPECT().GetWorksInfo(ctx, gomock.InAnyOrder([]string{existingWork, missingWork})).
+					Return([]entity.Work{{ID: existingWork, Name: "Work"}}, nil)
+
+				transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					},
+				)
+
+				bookRepo.EXPECT().AddBooks(ctx, []entity.Book{
+					{Name: "First", AuthorIDs: []string{existingAuthor}, GenreIDs: []string{}},
+					{Name: "Third", AuthorIDs: []string{}, PublisherID: existingPublisher, GenreIDs: []string{}},
+					{Name: "Sixth", AuthorIDs: []string{}, GenreIDs: []string{}, WorkID: existingWork},
+				}).DoAndReturn(func(_ context.Context, books []entity.Book) ([]entity.Book, error) {
+					for i := range books {
+						books[i].ID = uuid.NewString()
+					}
+					return books, tc.booksError
+				})
+
+				times := 0
+				if tc.booksError == nil {
+					times = 1
+				}
+				outboxRepo.EXPECT().SendMessages(ctx, gomock.Len(3)).Return(tc.outboxError).Times(times)
+			}
+
+			uc := New(zap.NewNop(), transactor, outboxRepo, authorRepo, bookRepo, mocks.NewMockChangeLogRepository(ctrl),
+				publisherRepo, genreRepo, mocks.NewMockSeriesRepository(ctrl), workRepo, mocks.NewMockCopyRepository(ctrl),
+				mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+				mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+				mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl),
+				mocks.NewMockReviewRepository(ctrl))
+			results, err := uc.AddBooks(ctx, requests)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if tc.expectedError != nil {
+				require.Equal(t, expS.Code(), s.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, results, len(requests))
+			for i, result := range results {
+				require.Equal(t, tc.expectedCodes[i], codes.Code(result.GetErrorCode()))
+				require.Equal(t, tc.expectedCodes[i] == codes.OK, result.GetBook() != nil)
+			}
+		})
+	}
+}
+
 func TestUpdateBook(t *testing.T) {
 	t.Parallel()
 
+	id := uuid.NewString()
+	name := "Test"
+	authorIDs := []string{"test"}
+	isbn := "9780306406157"
+	year, pageCount := 1999, 320
+	language, description, subtitle, publisherID := "en", "", "", uuid.NewString()
+	genreID := uuid.NewString()
+	genreIDs := []string{genreID}
+	translatorID, coauthorID := uuid.NewString(), uuid.NewString()
+	contributors := []entity.Contributor{
+		{AuthorID: translatorID, Role: entity.ContributorRoleTranslator, Position: 1},
+		{AuthorID: coauthorID, Role: entity.ContributorRoleAuthor, Position: 2},
+	}
+	contributorIDs := []string{translatorID, coauthorID}
+
+	fullUpdate := entity.BookUpdate{
+		ID:              id,
+		Name:            &name,
+		AuthorIDs:       &authorIDs,
+		ISBN:            &isbn,
+		PublicationYear: &year,
+		Language:        &language,
+		PageCount:       &pageCount,
+		Description:     &description,
+		Subtitle:        &subtitle,
+		PublisherID:     &publisherID,
+		GenreIDs:        &genreIDs,
+	}
+
+	fullRequest := &library.UpdateBookRequest{
+		Id:              id,
+		Name:            name,
+		AuthorIds:       authorIDs,
+		Isbn:            isbn,
+		PublicationYear: int32(year),
+		Language:        language,
+		PageCount:       int32(pageCount),
+		PublisherId:     publisherID,
+		GenreIds:        []string{genreID, genreID},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "author_ids", "isbn", "publication_year", "language",
+			"page_count", "description", "subtitle", "publisher_id", "genre_ids"}},
+	}
+
 	testCases := []struct {
-		name             string
-		request          *library.UpdateBookRequest
-		expectedResponse *library.UpdateBookResponse
-		repositoryError  error
-		expectedError    error
+		name            string
+		request         *library.UpdateBookRequest
+		expectedUpdate  entity.BookUpdate
+		repositoryError error
+		expectedError   error
 	}{
 		{
-			name: "Run without errors",
+			name:            "Run without errors",
+			request:         fullRequest,
+			expectedUpdate:  fullUpdate,
+			repositoryError: nil,
+			expectedError:   nil,
+		},
+		{
+			name: "Run with legacy request keeping metadata",
 			request: &library.UpdateBookRequest{
-				Id:        uuid.NewString(),
-				Name:      "Test",
-				AuthorIds: []string{"test"},
+				Id:        id,
+				Name:      name,
+				AuthorIds: authorIDs,
 			},
-			expectedResponse: &library.UpdateBookResponse{},
-			repositoryError:  nil,
-			expectedError:    nil,
+			expectedUpdate: entity.BookUpdate{ID: id, Name: &name, AuthorIDs: &authorIDs},
 		},
 		{
-			name: "Run with internal errors",
+			name: "Run with name mask",
 			request: &library.UpdateBookRequest{
-				Id:        uuid.NewString(),
-				Name:      "Test",
-				AuthorIds: []string{"test"},
+				Id:         id,
+				Name:       name,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
 			},
-			expectedResponse: &library.UpdateBookResponse{},
-			repositoryError:  errors.New("test error"),
-			expectedError:    status.Error(codes.Internal, "repository error"),
+			expectedUpdate:  entity.BookUpdate{ID: id, Name: &name},
+			repositoryError: nil,
+			expectedError:   nil,
 		},
 		{
-			name: "Run with not found errors",
+			name: "Run with metadata mask",
 			request: &library.UpdateBookRequest{
-				Id:        uuid.NewString(),
-				Name:      "Test",
-				AuthorIds: []string{"test"},
+				Id:              id,
+				Isbn:            isbn,
+				PublicationYear: int32(year),
+				UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"isbn", "publication_year"}},
 			},
-			expectedResponse: &library.UpdateBookResponse{},
-			repositoryError:  entity.ErrBookNotFound,
-			expectedError:    status.Error(codes.NotFound, "book not found"),
+			expectedUpdate:  entity.BookUpdate{ID: id, ISBN: &isbn, PublicationYear: &year},
+			repositoryError: nil,
+			expectedError:   nil,
+		},
+		{
+			name: "Run with contributors mask",
+			request: &library.UpdateBookRequest{
+				Id: id,
+				Contributors: []*library.Contributor{
+					{AuthorId: translatorID, Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+					{AuthorId: coauthorID},
+					{AuthorId: translatorID, Role: library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contributors"}},
+			},
+			expectedUpdate: entity.BookUpdate{ID: id, Contributors: &contributors},
+		},
+		{
+			name: "Run with contributors instead of author ids",
+			request: &library.UpdateBookRequest{
+				Id: id,
+				Contributors: []*library.Contributor{
+					{AuthorId: translatorID, Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+					{AuthorId: coauthorID, Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author_ids", "contributors"}},
+			},
+			expectedUpdate: entity.BookUpdate{ID: id, Contributors: &contributors},
+		},
+		{
+			name: "Run with author ids instead of contributors",
+			request: &library.UpdateBookRequest{
+				Id:         id,
+				AuthorIds:  contributorIDs,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author_ids", "contributors"}},
+			},
+			expectedUpdate: entity.BookUpdate{ID: id, AuthorIDs: &contributorIDs},
+		},
+		{
+			name:            "Run with unknown publisher",
+			request:         fullRequest,
+			expectedUpdate:  fullUpdate,
+			repositoryError: entity.ErrPublisherNotFound,
+			expectedError:   status.Error(codes.NotFound, "publisher not found"),
+		},
+		{
+			name:            "Run with duplicate isbn",
+			request:         fullRequest,
+			expectedUpdate:  fullUpdate,
+			repositoryError: entity.ErrBookISBNExists,
+			expectedError:   status.Error(codes.AlreadyExists, "book with this isbn already exists"),
+		},
+		{
+			name: "Run with unsupported mask path",
+			request: &library.UpdateBookRequest{
+				Id:         id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}},
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid update mask"),
+		},
+		{
+			name:            "Run with internal errors",
+			request:         fullRequest,
+			expectedUpdate:  fullUpdate,
+			repositoryError: errors.New("test error"),
+			expectedError:   status.Error(codes.Internal, "repository error"),
+		},
+		{
+			name:            "Run with not found errors",
+			request:         fullRequest,
+			expectedUpdate:  fullUpdate,
+			repositoryError: entity.ErrBookNotFound,
+			expectedError:   status.Error(codes.NotFound, "book not found"),
 		},
 	}
 	for _, tc := range testCases {
@@ -226,17 +528,20 @@ func TestUpdateBook(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			ctx := context.Background()
+			book := entity.Book{ID: id, Name: name, AuthorIDs: authorIDs}
 			bookRepo := mocks.NewMockBooksRepository(ctrl)
-			bookRepo.EXPECT().UpdateBook(ctx, tc.request.GetId(), tc.request.GetName(), tc.request.GetAuthorIds()).
-				Return(entity.Book{}, tc.repositoryError).AnyTimes()
+			bookRepo.EXPECT().UpdateBook(ctx, tc.expectedUpdate).
+				Return(book, tc.repositoryError).AnyTimes()
 
 			uc := getDefaultBookUseCase(ctrl, bookRepo)
-			_, err := uc.UpdateBook(ctx, tc.request)
+			resp, err := uc.UpdateBook(ctx, tc.request)
 			s, ok := status.FromError(err)
 			expS, expOk := status.FromError(tc.expectedError)
 			require.Equal(t, expOk, ok)
-			if ok {
+			if tc.expectedError != nil {
 				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, bookToProto(book), resp.GetBook())
 			}
 		})
 	}
@@ -249,6 +554,15 @@ func TestGetBookInfo(t *testing.T) {
 		name             string
 		request          *library.GetBookInfoRequest
 		expectedResponse *library.GetBookInfoResponse
+		seriesVolume     entity.SeriesVolume
+		seriesError      error
+		expectedSeries   *library.BookSeries
+		copyCounts       []entity.BranchCopyCounts
+		copyError        error
+		expectedTotal    int32
+		expectedAvail    int32
+		rating           entity.BookRating
+		expectedRating   float64
 		repositoryError  error
 		expectedError    error
 	}{
@@ -262,9 +576,67 @@ func TestGetBookInfo(t *testing.T) {
 				CreatedAt: timestamppb.New(time.Now()),
 				UpdatedAt: timestamppb.New(time.Now()),
 			}},
+			seriesError:     entity.ErrBookNotInSeries,
 			repositoryError: nil,
 			expectedError:   nil,
 		},
+		{
+			name:    "Run with series",
+			request: &library.GetBookInfoRequest{Id: "123"},
+			expectedResponse: &library.GetBookInfoResponse{Book: &library.Book{
+				Id:   "123",
+				Name: "Test",
+			}},
+			seriesVolume:   entity.SeriesVolume{SeriesID: "456", SeriesName: "Saga", BookID: "123", Volume: 2.5},
+			expectedSeries: &library.BookSeries{SeriesId: "456", SeriesName: "Saga", Volume: 2.5},
+		},
+		{
+			name:    "Run with series errors",
+			request: &library.GetBookInfoRequest{Id: "123"},
+			expectedResponse: &library.GetBookInfoResponse{Book: &library.Book{
+				Id:   "123",
+				Name: "Test",
+			}},
+			seriesError:   errors.New("test error"),
+			expectedError: status.Error(codes.Internal, "test error"),
+		},
+		{
+			name:    "Run with copies",
+			request: &library.GetBookInfoRequest{Id: "123"},
+			expectedResponse: &library.GetBookInfoResponse{Book: &library.Book{
+				Id:   "123",
+				Name: "Test",
+			}},
+			seriesError: entity.ErrBookNotInSeries,
+			copyCounts: []entity.BranchCopyCounts{
+				{BranchID: "1", BranchName: "Main", Total: 3, Available: 1},
+				{BranchID: "2", BranchName: "North", Total: 2, Available: 2},
+			},
+			expectedTotal: 5,
+			expectedAvail: 3,
+		},
+		{
+			name:    "Run with rating",
+			request: &library.GetBookInfoRequest{Id: "123"},
+			expectedResponse: &library.GetBookInfoResponse{Book: &library.Book{
+				Id:   "123",
+				Name: "Test",
+			}},
+			seriesError:    entity.ErrBookNotInSeries,
+			rating:         entity.BookRating{BookID: "123", RatingSum: 9, ReviewCount: 2},
+			expectedRating: 4.5,
+		},
+		{
+			name:    "Run with copy counts errors",
+			request: &library.GetBookInfoRequest{Id: "123"},
+			expectedResponse: &library.GetBookInfoResponse{Book: &library.Book{
+				Id:   "123",
+				Name: "Test",
+			}},
+			seriesError:   entity.ErrBookNotInSeries,
+			copyError:     errors.New("test error"),
+			expectedError: status.Error(codes.Internal, "test error"),
+		},
 		{
 			name:    "Run with internal errors",
 			request: &library.GetBookInfoRequest{Id: "123"},
@@ -307,7 +679,23 @@ func TestGetBookInfo(t *testing.T) {
 				tc.repositoryError,
 			)
 
-			uc := getDefaultBookUseCase(ctrl, bookRepo)
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			if tc.repositoryError == nil {
+				seriesRepo.EXPECT().GetBookSeries(ctx, tc.request.GetId()).Return(tc.seriesVolume, tc.seriesError)
+			}
+
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			if tc.repositoryError == nil && (tc.seriesError == nil || errors.Is(tc.seriesError, entity.ErrBookNotInSeries)) {
+				copyRepo.EXPECT().GetBranchCopyCounts(ctx, tc.request.GetId()).Return(tc.copyCounts, tc.copyError)
+			}
+
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			if tc.expectedError == nil {
+				reviewRepo.EXPECT().GetBookRating(ctx, tc.request.GetId()).Return(tc.rating, nil)
+			}
+
+			uc := New(zap.NewNop(), nil, nil, nil, bookRepo, nil, nil, nil, seriesRepo, nil, copyRepo, nil, nil, nil, nil, nil,
+				nil, reviewRepo)
 			resp, err := uc.GetBookInfo(ctx, tc.request)
 			s, ok := status.FromError(err)
 			expS, expOk := status.FromError(tc.expectedError)
@@ -317,6 +705,404 @@ func TestGetBookInfo(t *testing.T) {
 			} else {
 				require.Equal(t, tc.expectedResponse, resp)
 			}
+			if tc.expectedError == nil {
+				require.Equal(t, tc.expectedSeries, resp.GetSeries())
+				require.Equal(t, tc.expectedTotal, resp.GetTotalCopies())
+				require.Equal(t, tc.expectedAvail, resp.GetAvailableCopies())
+				require.InDelta(t, tc.expectedRating, resp.GetAverageRating(), 0.001)
+				require.Equal(t, int32(tc.rating.ReviewCount), resp.GetReviewCount())
+				require.Len(t, resp.GetBranches(), len(tc.copyCounts))
+				for i, branch := range tc.copyCounts {
+					require.Equal(t, branch.BranchID, resp.GetBranches()[i].GetBranchId())
+					require.Equal(t, int32(branch.Available), resp.GetBranches()[i].GetAvailableCopies())
+				}
+			}
+		})
+	}
+}
+
+func TestGetBookByISBN(t *testing.T) {
+	t.Parallel()
+
+	book := entity.Book{
+		ID:              uuid.NewString(),
+		Name:            "Test",
+		AuthorIDs:       []string{"test"},
+		ISBN:            "9780306406157",
+		PublicationYear: 1999,
+		Language:        "en",
+		PageCount:       320,
+		Description:     "Description",
+		Subtitle:        "Subtitle",
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test error"),
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrBookNotFound,
+			expectedError:   status.Error(codes.NotFound, "book not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().GetBookByISBN(ctx, book.ISBN).Return(book, tc.repositoryError)
+
+			uc := getDefaultBookUseCase(ctrl, bookRepo)
+			resp, err := uc.GetBookByISBN(ctx, &library.GetBookByISBNRequest{Isbn: book.ISBN})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, bookToProto(book), resp.GetBook())
+			require.Equal(t, int32(320), resp.GetBook().GetPageCount())
+		})
+	}
+}
+
+func TestDeleteBook(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		request         *library.DeleteBookRequest
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name:          "Run without errors",
+			request:       &library.DeleteBookRequest{Id: "123"},
+			expectedError: nil,
+		},
+		{
+			name:            "Run with not found errors",
+			request:         &library.DeleteBookRequest{Id: "123"},
+			repositoryError: entity.ErrBookNotFound,
+			expectedError:   status.Error(codes.NotFound, "book not found"),
+		},
+		{
+			name:            "Run with book in circulation",
+			request:         &library.DeleteBookRequest{Id: "123"},
+			repositoryError: entity.ErrBookInCirculation,
+			expectedError:   status.Error(codes.FailedPrecondition, "book has active loans, holds or transfers"),
+		},
+		{
+			name:          "Run with outbox errors",
+			request:       &library.DeleteBookRequest{Id: "123"},
+			outboxError:   errors.New("outbox err"),
+			expectedError: status.Error(codes.Internal, "outbox err"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			deletedAt := time.Now()
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().DeleteBook(ctx, tc.request.GetId()).
+				Return(entity.Book{ID: tc.request.GetId(), Version: 4, DeletedAt: &deletedAt}, tc.repositoryError)
+
+			transactor := mocks.NewMockTransactor(ctrl)
+			transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				},
+			)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			// The deletion bumps the version, so the key of a book deleted again after restoring differs.
+			idempotencyKey := repository.OutboxKindBookDeleted.String() + "_" + tc.request.GetId() + "_4"
+			outboxRepo.EXPECT().SendMessage(ctx, idempotencyKey, repository.OutboxKindBookDeleted, gomock.Any()).
+				Return(tc.outboxError).Times(times)
+
+			uc := getDefaultBookUseCaseWithOutbox(ctrl, bookRepo, transactor, outboxRepo)
+			_, err := uc.DeleteBook(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			}
+		})
+	}
+}
+
+func TestRestoreBook(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		request          *library.RestoreBookRequest
+		expectedResponse *library.RestoreBookResponse
+		repositoryError  error
+		expectedError    error
+	}{
+		{
+			name:    "Run without errors",
+			request: &library.RestoreBookRequest{Id: "123"},
+			expectedResponse: &library.RestoreBookResponse{Book: &library.Book{
+				Id:        "123",
+				Name:      "Test",
+				AuthorIds: []string{"test"},
+				CreatedAt: timestamppb.New(time.Now()),
+				UpdatedAt: timestamppb.New(time.Now()),
+			}},
+			repositoryError: nil,
+			expectedError:   nil,
+		},
+		{
+			name:             "Run with not found errors",
+			request:          &library.RestoreBookRequest{Id: "123"},
+			expectedResponse: &library.RestoreBookResponse{Book: &library.Book{}},
+			repositoryError:  entity.ErrBookNotFound,
+			expectedError:    status.Error(codes.NotFound, "book not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().RestoreBook(ctx, tc.request.GetId()).Return(
+				entity.Book{
+					ID:        tc.expectedResponse.GetBook().GetId(),
+					Name:      tc.expectedResponse.GetBook().GetName(),
+					AuthorIDs: tc.expectedResponse.GetBook().GetAuthorIds(),
+					CreatedAt: tc.expectedResponse.GetBook().GetCreatedAt().AsTime(),
+					UpdatedAt: tc.expectedResponse.GetBook().GetUpdatedAt().AsTime(),
+				},
+				tc.repositoryError,
+			)
+
+			transactor := mocks.NewMockTransactor(ctrl)
+			transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				},
+			)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			outboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindBook, gomock.Any()).
+				Return(nil).Times(times)
+
+			uc := getDefaultBookUseCaseWithOutbox(ctrl, bookRepo, transactor, outboxRepo)
+			resp, err := uc.RestoreBook(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, tc.expectedResponse, resp)
+			}
+		})
+	}
+}
+
+func TestListBooks(t *testing.T) {
+	t.Parallel()
+
+	books := []entity.Book{
+		{ID: uuid.NewString(), Name: "a", AuthorIDs: []string{"test"}, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: uuid.NewString(), Name: "b", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: uuid.NewString(), Name: "c", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+
+	validToken, err := encodePageToken(entity.ListBooksParams{OrderBy: entity.BookOrderByName},
+		bookPageToken{ID: books[0].ID, Name: books[0].Name})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		request           *library.ListBooksRequest
+		repositoryBooks   []entity.Book
+		repositoryError   error
+		expectedLimit     int
+		expectedAfter     *entity.BookCursor
+		expectedBooks     int
+		expectedNextToken bool
+		expectedError     error
+	}{
+		{
+			name:              "Run with next page",
+			request:           &library.ListBooksRequest{PageSize: 2},
+			repositoryBooks:   books,
+			expectedLimit:     3,
+			expectedBooks:     2,
+			expectedNextToken: true,
+		},
+		{
+			name:            "Run with last page",
+			request:         &library.ListBooksRequest{PageSize: 2, PageToken: validToken},
+			repositoryBooks: books[1:],
+			expectedLimit:   3,
+			expectedAfter:   &entity.BookCursor{ID: books[0].ID, Name: books[0].Name},
+			expectedBooks:   2,
+		},
+		{
+			name:            "Run with default page size",
+			request:         &library.ListBooksRequest{},
+			repositoryBooks: books,
+			expectedLimit:   defaultPageSize + 1,
+			expectedBooks:   3,
+		},
+		{
+			name:          "Run with malformed page token",
+			request:       &library.ListBooksRequest{PageToken: "???"},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name: "Run with page token of another order",
+			request: &library.ListBooksRequest{
+				PageToken: validToken,
+				OrderBy:   library.BookOrderBy_BOOK_ORDER_BY_CREATED_AT,
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name: "Run with page token of another filter",
+			request: &library.ListBooksRequest{
+				PageToken:  validToken,
+				NamePrefix: "b",
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:            "Run with internal errors",
+			request:         &library.ListBooksRequest{},
+			repositoryError: errors.New("test error"),
+			expectedLimit:   defaultPageSize + 1,
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().ListBooks(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, params entity.ListBooksParams) ([]entity.Book, error) {
+					require.Equal(t, tc.expectedLimit, params.Limit)
+					require.Equal(t, tc.expectedAfter, params.After)
+					return tc.repositoryBooks, tc.repositoryError
+				},
+			).MaxTimes(1)
+
+			uc := getDefaultBookUseCase(ctrl, bookRepo)
+			resp, err := uc.ListBooks(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Len(t, resp.GetBooks(), tc.expectedBooks)
+				require.Equal(t, tc.expectedNextToken, resp.GetNextPageToken() != "")
+			}
+		})
+	}
+}
+
+func TestBatchGetBooks(t *testing.T) {
+	t.Parallel()
+
+	found := uuid.NewString()
+	missing := uuid.NewString()
+
+	testCases := []struct {
+		name             string
+		request          *library.BatchGetBooksRequest
+		repositoryBooks  []entity.Book
+		repositoryError  error
+		expectedResponse *library.BatchGetBooksResponse
+		expectedError    error
+	}{
+		{
+			name: "Run without errors",
+			request: &library.BatchGetBooksRequest{
+				Ids: []string{missing, strings.ToUpper(found), found},
+			},
+			repositoryBooks: []entity.Book{{ID: found, Name: "Test", Version: 1}},
+			expectedResponse: &library.BatchGetBooksResponse{
+				Books: []*library.Book{{
+					Id:        found,
+					Name:      "Test",
+					CreatedAt: timestamppb.New(time.Time{}),
+					UpdatedAt: timestamppb.New(time.Time{}),
+					Etag:      "1",
+				}},
+				MissingIds: []string{missing},
+			},
+		},
+		{
+			name: "Run with internal error",
+			request: &library.BatchGetBooksRequest{
+				Ids: []string{found},
+			},
+			repositoryError: errors.New("repository error"),
+			expectedError:   status.Error(codes.Internal, "repository error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().GetBooksInfo(ctx, normalizeIDs(tc.request.GetIds())).
+				Return(tc.repositoryBooks, tc.repositoryError)
+
+			uc := getDefaultBookUseCase(ctrl, bookRepo)
+			resp, err := uc.BatchGetBooks(ctx, tc.request)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if tc.expectedError != nil {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, tc.expectedResponse, resp)
+			}
 		})
 	}
 }
@@ -0,0 +1,190 @@
+package library
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) CreateBranch(ctx context.Context, request *library.CreateBranchRequest) (*library.CreateBranchResponse, error) {
+	l.logger.Info("Create branch request is being made to the database.")
+	branch, err := l.branchRepository.CreateBranch(ctx, entity.Branch{
+		Name:    request.GetName(),
+		Address: request.GetAddress(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.CreateBranchResponse{
+		Branch: branchToProto(branch),
+	}, nil
+}
+
+func (l *libraryImpl) ListBranches(ctx context.Context, _ *library.ListBranchesRequest) (*library.ListBranchesResponse, error) {
+	l.logger.Info("List branches request is being made to the database.")
+	branches, err := l.branchRepository.ListBranches(ctx)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListBranchesResponse{
+		Branches: make([]*library.Branch, 0, len(branches)),
+	}
+
+	for _, branch := range branches {
+		response.Branches = append(response.Branches, branchToProto(branch))
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) RequestTransfer(ctx context.Context, request *library.RequestTransferRequest) (*library.RequestTransferResponse, error) {
+	var transfer entity.Transfer
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Request transfer request is being made to the database.")
+
+		bookCopy, txErr := l.copyRepository.LockCopy(ctx, request.GetCopyId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case bookCopy.RetiredAt != nil:
+			return entity.ErrCopyRetired
+		case bookCopy.Status != entity.CopyStatusAvailable:
+			return entity.ErrCopyNotAvailable
+		case bookCopy.CurrentBranchID == request.GetToBranchId():
+			return entity.ErrTransferSameBranch
+		}
+
+		transfer, txErr = l.transferRepository.CreateTransfer(ctx, bookCopy.ID, request.GetToBranchId())
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RequestTransferResponse{
+		Transfer: transferToProto(transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ShipTransfer(ctx context.Context, request *library.ShipTransferRequest) (*library.ShipTransferResponse, error) {
+	var transfer entity.Transfer
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Ship transfer request is being made to the database.")
+
+		current, txErr := l.transferRepository.LockTransfer(ctx, request.GetId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case current.Status != entity.TransferStatusRequested:
+			return entity.ErrTransferNotRequested
+		}
+
+		bookCopy, txErr := l.copyRepository.LockCopy(ctx, current.CopyID)
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case bookCopy.RetiredAt != nil:
+			return entity.ErrCopyRetired
+		// A copy on hold is only transferred to the pickup branch of its hold.
+		case bookCopy.Status != entity.CopyStatusAvailable && bookCopy.Status != entity.CopyStatusOnHold:
+			return entity.ErrCopyNotAvailable
+		}
+
+		transfer, txErr = l.transferRepository.ShipTransfer(ctx, current.ID)
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.ShipTransferResponse{
+		Transfer: transferToProto(transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ReceiveTransfer(ctx context.Context, request *library.ReceiveTransferRequest) (*library.ReceiveTransferResponse, error) {
+	var transfer entity.Transfer
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Receive transfer request is being made to the database.")
+
+		current, txErr := l.transferRepository.LockTransfer(ctx, request.GetId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case current.Status != entity.TransferStatusInTransit:
+			return entity.ErrTransferNotInTransit
+		}
+
+		bookCopy, txErr := l.copyRepository.LockCopy(ctx, current.CopyID)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		transfer, txErr = l.transferRepository.ReceiveTransfer(ctx, current.ID)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		hold, txErr := l.holdRepository.ArriveHold(ctx, bookCopy.ID)
+
+		switch {
+		case errors.Is(txErr, entity.ErrHoldNotFound):
+			// The copy was not sent for a hold, or the hold was cancelled on the way.
+			_, txErr = l.promoteNextHold(ctx, bookCopy.BookID, bookCopy.ID)
+			return txErr
+		case txErr != nil:
+			return txErr
+		}
+
+		return l.notifyHoldReady(ctx, hold)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.ReceiveTransferResponse{
+		Transfer: transferToProto(transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ListTransfers(ctx context.Context, request *library.ListTransfersRequest) (*library.ListTransfersResponse, error) {
+	l.logger.Info("List transfers request is being made to the database.")
+	transfers, err := l.transferRepository.ListTransfers(ctx, entity.TransferFilter{
+		BranchID: request.GetBranchId(),
+		Status:   entity.TransferStatus(request.GetStatus()),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListTransfersResponse{
+		Transfers: make([]*library.Transfer, 0, len(transfers)),
+	}
+
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, transferToProto(transfer))
+	}
+
+	return response, nil
+}
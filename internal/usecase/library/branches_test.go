@@ -0,0 +1,396 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultBranchUseCase(
+	ctrl *gomock.Controller,
+	outboxRepository *mocks.MockOutboxRepository,
+	copyRepository *mocks.MockCopyRepository,
+	holdRepository *mocks.MockHoldRepository,
+	branchRepository *mocks.MockBranchRepository,
+	transferRepository *mocks.MockTransferRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, outboxRepository, mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		copyRepository, mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl), holdRepository,
+		mocks.NewMockFineRepository(ctrl), branchRepository, transferRepository, mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestCreateBranch(t *testing.T) {
+	t.Parallel()
+
+	branch := entity.Branch{ID: uuid.NewString(), Name: "North", Address: "1 Main St", CreatedAt: time.Now()}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with duplicate name errors",
+			repositoryError: entity.ErrBranchExists,
+			expectedError:   status.Error(codes.AlreadyExists, "branch with this name already exists"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			branchRepo := mocks.NewMockBranchRepository(ctrl)
+			branchRepo.EXPECT().CreateBranch(ctx, entity.Branch{Name: branch.Name, Address: branch.Address}).
+				Return(branch, tc.repositoryError)
+
+			uc := getDefaultBranchUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+				mocks.NewMockHoldRepository(ctrl), branchRepo, mocks.NewMockTransferRepository(ctrl),
+				mocks.NewMockTransactor(ctrl))
+			resp, err := uc.CreateBranch(ctx, &library.CreateBranchRequest{Name: branch.Name, Address: branch.Address})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, branchToProto(branch), resp.GetBranch())
+		})
+	}
+}
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+
+	branches := []entity.Branch{{ID: uuid.NewString(), Name: "Main"}, {ID: uuid.NewString(), Name: "North"}}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			branchRepo := mocks.NewMockBranchRepository(ctrl)
+			branchRepo.EXPECT().ListBranches(ctx).Return(branches, tc.repositoryError)
+
+			uc := getDefaultBranchUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+				mocks.NewMockHoldRepository(ctrl), branchRepo, mocks.NewMockTransferRepository(ctrl),
+				mocks.NewMockTransactor(ctrl))
+			resp, err := uc.ListBranches(ctx, &library.ListBranchesRequest{})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetBranches(), 2)
+			require.Equal(t, "North", resp.GetBranches()[1].GetName())
+		})
+	}
+}
+
+func TestRequestTransfer(t *testing.T) {
+	t.Parallel()
+
+	fromBranchID := uuid.NewString()
+	toBranchID := uuid.NewString()
+	bookCopy := entity.Copy{ID: uuid.NewString(), CurrentBranchID: fromBranchID, Status: entity.CopyStatusAvailable}
+	transfer := entity.Transfer{
+		ID:           uuid.NewString(),
+		CopyID:       bookCopy.ID,
+		FromBranchID: fromBranchID,
+		ToBranchID:   toBranchID,
+		Status:       entity.TransferStatusRequested,
+	}
+	retiredAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		copy          entity.Copy
+		toBranchID    string
+		createError   error
+		expectedError error
+	}{
+		{
+			name:       "Run without errors",
+			copy:       bookCopy,
+			toBranchID: toBranchID,
+		},
+		{
+			name:          "Run with copy at the destination",
+			copy:          bookCopy,
+			toBranchID:    fromBranchID,
+			expectedError: status.Error(codes.FailedPrecondition, "copy is already at this branch"),
+		},
+		{
+			name:          "Run with copy on loan",
+			copy:          entity.Copy{ID: bookCopy.ID, CurrentBranchID: fromBranchID, Status: entity.CopyStatusOnLoan},
+			toBranchID:    toBranchID,
+			expectedError: status.Error(codes.FailedPrecondition, "copy is not available"),
+		},
+		{
+			name: "Run with retired copy",
+			copy: entity.Copy{ID: bookCopy.ID, CurrentBranchID: fromBranchID, Status: entity.CopyStatusAvailable,
+				RetiredAt: &retiredAt},
+			toBranchID:    toBranchID,
+			expectedError: status.Error(codes.FailedPrecondition, "copy is retired"),
+		},
+		{
+			name:          "Run with transfer exists errors",
+			copy:          bookCopy,
+			toBranchID:    toBranchID,
+			createError:   entity.ErrTransferExists,
+			expectedError: status.Error(codes.AlreadyExists, "copy is already being transferred"),
+		},
+		{
+			name:          "Run with branch not found errors",
+			copy:          bookCopy,
+			toBranchID:    toBranchID,
+			createError:   entity.ErrBranchNotFound,
+			expectedError: status.Error(codes.NotFound, "branch not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().LockCopy(ctx, bookCopy.ID).Return(tc.copy, nil)
+
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			transferRepo.EXPECT().CreateTransfer(ctx, bookCopy.ID, tc.toBranchID).Return(transfer, tc.createError).
+				AnyTimes()
+
+			uc := getDefaultBranchUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), copyRepo,
+				mocks.NewMockHoldRepository(ctrl), mocks.NewMockBranchRepository(ctrl), transferRepo,
+				newPassingTransactor(ctx, ctrl))
+			resp, err := uc.RequestTransfer(ctx, &library.RequestTransferRequest{
+				CopyId:     bookCopy.ID,
+				ToBranchId: tc.toBranchID,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, transferToProto(transfer), resp.GetTransfer())
+		})
+	}
+}
+
+func TestShipTransfer(t *testing.T) {
+	t.Parallel()
+
+	requested := entity.Transfer{ID: uuid.NewString(), CopyID: uuid.NewString(), Status: entity.TransferStatusRequested}
+	shippedAt := time.Now()
+	shipped := entity.Transfer{ID: requested.ID, CopyID: requested.CopyID, Status: entity.TransferStatusInTransit,
+		ShippedAt: &shippedAt}
+
+	testCases := []struct {
+		name          string
+		transfer      entity.Transfer
+		copyStatus    entity.CopyStatus
+		expectedError error
+	}{
+		{
+			name:       "Run without errors",
+			transfer:   requested,
+			copyStatus: entity.CopyStatusAvailable,
+		},
+		{
+			name:       "Run with copy held for the destination",
+			transfer:   requested,
+			copyStatus: entity.CopyStatusOnHold,
+		},
+		{
+			name:          "Run with copy on loan",
+			transfer:      requested,
+			copyStatus:    entity.CopyStatusOnLoan,
+			expectedError: status.Error(codes.FailedPrecondition, "copy is not available"),
+		},
+		{
+			name:          "Run with shipped transfer",
+			transfer:      shipped,
+			expectedError: status.Error(codes.FailedPrecondition, "transfer is not waiting for shipment"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			transferRepo.EXPECT().LockTransfer(ctx, requested.ID).Return(tc.transfer, nil)
+
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().LockCopy(ctx, requested.CopyID).
+				Return(entity.Copy{ID: requested.CopyID, Status: tc.copyStatus}, nil).AnyTimes()
+
+			if tc.expectedError == nil {
+				transferRepo.EXPECT().ShipTransfer(ctx, requested.ID).Return(shipped, nil)
+			}
+
+			uc := getDefaultBranchUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), copyRepo,
+				mocks.NewMockHoldRepository(ctrl), mocks.NewMockBranchRepository(ctrl), transferRepo,
+				newPassingTransactor(ctx, ctrl))
+			resp, err := uc.ShipTransfer(ctx, &library.ShipTransferRequest{Id: requested.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT, resp.GetTransfer().GetStatus())
+			require.NotNil(t, resp.GetTransfer().GetShippedAt())
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	t.Parallel()
+
+	bookCopy := entity.Copy{ID: uuid.NewString(), BookID: uuid.NewString()}
+	shipped := entity.Transfer{ID: uuid.NewString(), CopyID: bookCopy.ID, Status: entity.TransferStatusInTransit}
+	received := entity.Transfer{ID: shipped.ID, CopyID: bookCopy.ID, Status: entity.TransferStatusReceived}
+	readyUntil := time.Now().AddDate(0, 0, 7)
+	arrived := entity.Hold{ID: uuid.NewString(), BookID: bookCopy.BookID, CopyID: bookCopy.ID,
+		Status: entity.HoldStatusReady, ReadyUntil: &readyUntil}
+
+	testCases := []struct {
+		name          string
+		transfer      entity.Transfer
+		hold          *entity.Hold
+		expectedError error
+	}{
+		{
+			name:     "Run with copy sent for a hold",
+			transfer: shipped,
+			hold:     &arrived,
+		},
+		{
+			name:     "Run with copy not sent for a hold",
+			transfer: shipped,
+		},
+		{
+			name:          "Run with requested transfer",
+			transfer:      entity.Transfer{ID: shipped.ID, CopyID: bookCopy.ID, Status: entity.TransferStatusRequested},
+			expectedError: status.Error(codes.FailedPrecondition, "transfer is not in transit"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			transferRepo.EXPECT().LockTransfer(ctx, shipped.ID).Return(tc.transfer, nil)
+
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			if tc.expectedError == nil {
+				copyRepo.EXPECT().LockCopy(ctx, bookCopy.ID).Return(bookCopy, nil)
+				transferRepo.EXPECT().ReceiveTransfer(ctx, shipped.ID).Return(received, nil)
+			}
+
+			switch {
+			case tc.hold != nil:
+				holdRepo.EXPECT().ArriveHold(ctx, bookCopy.ID).Return(*tc.hold, nil)
+				outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindHoldReady.String()+"_"+tc.hold.ID,
+					repository.OutboxKindHoldReady, gomock.Any()).Return(nil)
+			case tc.expectedError == nil:
+				holdRepo.EXPECT().ArriveHold(ctx, bookCopy.ID).Return(entity.Hold{}, entity.ErrHoldNotFound)
+				holdRepo.EXPECT().PromoteNextHold(ctx, bookCopy.BookID, bookCopy.ID).
+					Return(entity.Hold{}, entity.ErrHoldNotFound)
+			}
+
+			uc := getDefaultBranchUseCase(ctrl, outboxRepo, copyRepo, holdRepo, mocks.NewMockBranchRepository(ctrl),
+				transferRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.ReceiveTransfer(ctx, &library.ReceiveTransferRequest{Id: shipped.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.TransferStatus_TRANSFER_STATUS_RECEIVED, resp.GetTransfer().GetStatus())
+		})
+	}
+}
+
+func TestListTransfers(t *testing.T) {
+	t.Parallel()
+
+	branchID := uuid.NewString()
+	transfers := []entity.Transfer{
+		{ID: uuid.NewString(), FromBranchID: branchID, Status: entity.TransferStatusInTransit},
+	}
+
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	transferRepo.EXPECT().ListTransfers(ctx, entity.TransferFilter{
+		BranchID: branchID,
+		Status:   entity.TransferStatusInTransit,
+	}).Return(transfers, nil)
+
+	uc := getDefaultBranchUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockBranchRepository(ctrl), transferRepo,
+		mocks.NewMockTransactor(ctrl))
+	resp, err := uc.ListTransfers(ctx, &library.ListTransfersRequest{
+		BranchId: branchID,
+		Status:   library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetTransfers(), 1)
+	require.Equal(t, branchID, resp.GetTransfers()[0].GetFromBranchId())
+}
@@ -0,0 +1,72 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	changesBatchSize = 500
+	// changesPollInterval bounds the delay when a notification is lost or the announced changes waited for an older
+	// transaction to finish before they were published.
+	changesPollInterval = 30 * time.Second
+)
+
+func (l *libraryImpl) StreamChanges(ctx context.Context, request *library.StreamChangesRequest, resp library.Library_StreamChangesServer) error {
+	// Subscribe before reading, otherwise a change committed in between is only seen on the next poll.
+	notifications, unsubscribe := l.changeLogRepository.SubscribeChanges()
+	defer unsubscribe()
+
+	from := request.GetFromSequence()
+	l.logger.Info("Stream changes request is being made to the database.", zap.Int64("from_sequence", from))
+
+	for {
+		changes, err := l.changeLogRepository.GetChanges(ctx, from, changesBatchSize)
+
+		if err != nil {
+			return l.convertErr(err)
+		}
+
+		for _, change := range changes {
+			if err := resp.Send(changeToProto(change)); err != nil {
+				l.logger.Error("Error while sending change.", zap.Error(err))
+				return err
+			}
+
+			from = change.Sequence + 1
+		}
+
+		if len(changes) == changesBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return l.convertErr(ctx.Err())
+		case <-notifications:
+		case <-time.After(changesPollInterval):
+		}
+	}
+}
+
+func changeToProto(change entity.Change) *library.Change {
+	result := &library.Change{
+		Sequence:  change.Sequence,
+		Operation: library.ChangeOperation(change.Operation),
+		CreatedAt: timestamppb.New(change.CreatedAt),
+	}
+
+	switch {
+	case change.Book != nil:
+		result.Entity = &library.Change_Book{Book: bookToProto(*change.Book)}
+	case change.Author != nil:
+		result.Entity = &library.Change_Author{Author: authorToProto(*change.Author)}
+	}
+
+	return result
+}
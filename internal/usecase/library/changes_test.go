@@ -0,0 +1,145 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := []entity.Change{
+		{
+			Sequence:  5,
+			Kind:      entity.ChangeKindAuthor,
+			Operation: entity.ChangeOperationCreated,
+			Author:    &entity.Author{ID: uuid.NewString(), Name: "Author", Version: 1},
+			CreatedAt: time.Now(),
+		},
+		{
+			Sequence:  6,
+			Kind:      entity.ChangeKindBook,
+			Operation: entity.ChangeOperationDeleted,
+			Book:      &entity.Book{ID: uuid.NewString(), Name: "Book", Version: 2},
+			CreatedAt: time.Now(),
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		notified        bool
+		batches         [][]entity.Change
+		repositoryError error
+		sendError       error
+		expectedFrom    []int64
+		expectedSent    int
+		expectedError   error
+	}{
+		{
+			name:          "Replay and wait for cancellation",
+			batches:       [][]entity.Change{changes},
+			expectedFrom:  []int64{5},
+			expectedSent:  2,
+			expectedError: status.Error(codes.Canceled, "context canceled"),
+		},
+		{
+			name:          "Wake up on notification",
+			notified:      true,
+			batches:       [][]entity.Change{{}, changes[1:]},
+			expectedFrom:  []int64{5, 5},
+			expectedSent:  1,
+			expectedError: status.Error(codes.Canceled, "context canceled"),
+		},
+		{
+			name:            "Repository error",
+			batches:         [][]entity.Change{nil},
+			repositoryError: errors.New("repository error"),
+			expectedFrom:    []int64{5},
+			expectedError:   status.Error(codes.Internal, "repository error"),
+		},
+		{
+			name:          "Send error",
+			batches:       [][]entity.Change{changes},
+			sendError:     status.Error(codes.Unavailable, "send error"),
+			expectedFrom:  []int64{5},
+			expectedSent:  1,
+			expectedError: status.Error(codes.Unavailable, "send error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			notifications := make(chan struct{}, 1)
+			if tc.notified {
+				notifications <- struct{}{}
+			}
+
+			unsubscribed := false
+			changeLogRepo := mocks.NewMockChangeLogRepository(ctrl)
+			changeLogRepo.EXPECT().SubscribeChanges().Return(notifications, func() { unsubscribed = true })
+
+			calls := make([]any, 0, len(tc.batches))
+			for i, batch := range tc.batches {
+				last := i == len(tc.batches)-1
+				calls = append(calls, changeLogRepo.EXPECT().GetChanges(ctx, tc.expectedFrom[i], changesBatchSize).
+					DoAndReturn(func(context.Context, int64, int) ([]entity.Change, error) {
+						if last && tc.repositoryError == nil && tc.sendError == nil {
+							cancel()
+						}
+						return batch, tc.repositoryError
+					}))
+			}
+			gomock.InOrder(calls...)
+
+			sent := 0
+			server := mocks.NewMockStreamChangesServer(ctrl)
+			server.EXPECT().Send(gomock.Any()).DoAndReturn(func(change *library.Change) error {
+				sent++
+				return tc.sendError
+			}).AnyTimes()
+
+			uc := New(zap.NewNop(), nil, nil, nil, nil, changeLogRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := uc.StreamChanges(ctx, &library.StreamChangesRequest{FromSequence: 5}, server)
+
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			require.Equal(t, expS.Code(), s.Code())
+			require.Equal(t, tc.expectedSent, sent)
+			require.True(t, unsubscribed)
+		})
+	}
+}
+
+func TestChangeToProto(t *testing.T) {
+	t.Parallel()
+
+	book := entity.Book{ID: uuid.NewString(), Name: "Book", Version: 3}
+	change := changeToProto(entity.Change{
+		Sequence:  1,
+		Kind:      entity.ChangeKindBook,
+		Operation: entity.ChangeOperationRestored,
+		Book:      &book,
+	})
+
+	require.Equal(t, library.ChangeOperation_CHANGE_OPERATION_RESTORED, change.GetOperation())
+	require.Equal(t, bookToProto(book), change.GetBook())
+	require.Nil(t, change.GetAuthor())
+}
@@ -0,0 +1,230 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) CheckoutCopy(ctx context.Context, request *library.CheckoutCopyRequest) (*library.CheckoutCopyResponse, error) {
+	var loan entity.Loan
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Checkout copy request is being made to the database.")
+
+		// The patron is locked first, so concurrent checkouts can not exceed the loan limit.
+		policy, txErr := l.lockBorrower(ctx, request.GetPatronId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = l.checkFineBalance(ctx, request.GetPatronId(), policy.Tier); txErr != nil {
+			return txErr
+		}
+
+		loans, txErr := l.loanRepository.CountActiveLoans(ctx, request.GetPatronId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		if loans >= policy.MaxLoans {
+			return entity.ErrLoanLimitReached
+		}
+
+		bookCopy, txErr := l.copyRepository.LockCopyByBarcode(ctx, request.GetBarcode())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case bookCopy.RetiredAt != nil:
+			return entity.ErrCopyRetired
+		case bookCopy.Status == entity.CopyStatusOnHold:
+			if txErr = l.checkHeldFor(ctx, bookCopy.ID, request.GetPatronId()); txErr != nil {
+				return txErr
+			}
+		case bookCopy.Status != entity.CopyStatusAvailable:
+			return entity.ErrCopyNotAvailable
+		}
+
+		branchID := request.GetPickupBranchId()
+
+		switch {
+		case branchID == "":
+			branchID = bookCopy.CurrentBranchID
+		case branchID != bookCopy.CurrentBranchID:
+			return entity.ErrCopyAtOtherBranch
+		}
+
+		loan, txErr = l.loanRepository.CreateLoan(ctx, bookCopy.ID, request.GetPatronId(), branchID, policy.LoanDays)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		// Borrowing any copy of the book fulfills the patron's hold on it.
+		released, txErr := l.holdRepository.FulfillHolds(ctx, request.GetPatronId(), bookCopy.BookID, bookCopy.ID)
+
+		if txErr != nil || released == "" {
+			return txErr
+		}
+
+		// The released copy is not sent to the pickup branch of the fulfilled hold anymore.
+		if _, txErr = l.transferRepository.CancelPendingTransfer(ctx, released); txErr != nil {
+			return txErr
+		}
+
+		_, txErr = l.promoteNextHold(ctx, bookCopy.BookID, released)
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.CheckoutCopyResponse{
+		Loan: loanToProto(loan),
+	}, nil
+}
+
+func (l *libraryImpl) ReturnCopy(ctx context.Context, request *library.ReturnCopyRequest) (*library.ReturnCopyResponse, error) {
+	var (
+		loan entity.Loan
+		fine *entity.Fine
+	)
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Return copy request is being made to the database.")
+
+		active, txErr := l.loanRepository.LockActiveLoanByBarcode(ctx, request.GetBarcode())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		loan, txErr = l.loanRepository.CloseLoan(ctx, active.ID, request.GetBranchId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		assessed, txErr := l.fineRepository.AssessLoanFine(ctx, loan.ID)
+
+		switch {
+		case errors.Is(txErr, entity.ErrFineNotFound):
+			// The copy is returned in time or within the grace period.
+		case txErr != nil:
+			return txErr
+		default:
+			fine = &assessed
+		}
+
+		_, txErr = l.promoteNextHold(ctx, loan.BookID, loan.CopyID)
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ReturnCopyResponse{
+		Loan: loanToProto(loan),
+	}
+
+	if fine != nil {
+		response.Fine = fineToProto(*fine)
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) RenewLoan(ctx context.Context, request *library.RenewLoanRequest) (*library.RenewLoanResponse, error) {
+	var loan entity.Loan
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Renew loan request is being made to the database.")
+
+		current, txErr := l.loanRepository.LockLoan(ctx, request.GetId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case current.ReturnedAt != nil:
+			return entity.ErrLoanReturned
+		case current.Overdue(time.Now()):
+			// Renewing would move the due date past the overdue days before the fine job has assessed them.
+			return entity.ErrLoanOverdue
+		}
+
+		policy, txErr := l.lockBorrower(ctx, current.PatronID)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		if current.Renewals >= policy.MaxRenewals {
+			return entity.ErrRenewalLimitReached
+		}
+
+		// An overdue loan is not renewed past its fine, the fine would otherwise never be settled.
+		fine, txErr := l.fineRepository.GetLoanFine(ctx, current.ID)
+
+		switch {
+		case errors.Is(txErr, entity.ErrFineNotFound):
+		case txErr != nil:
+			return txErr
+		case fine.Status == entity.FineStatusAccruing:
+			return entity.ErrLoanFined
+		}
+
+		loan, txErr = l.loanRepository.RenewLoan(ctx, current.ID, policy.LoanDays)
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RenewLoanResponse{
+		Loan: loanToProto(loan),
+	}, nil
+}
+
+// checkHeldFor checks that the copy held for pickup is held for the patron.
+func (l *libraryImpl) checkHeldFor(ctx context.Context, copyID string, patronID string) error {
+	hold, err := l.holdRepository.LockReadyHoldByCopy(ctx, copyID)
+
+	switch {
+	case errors.Is(err, entity.ErrHoldNotFound):
+		return entity.ErrCopyOnHold
+	case err != nil:
+		return err
+	case hold.PatronID != patronID:
+		return entity.ErrCopyOnHold
+	}
+
+	return nil
+}
+
+// lockBorrower locks the patron, checks that they may borrow and returns the loan policy of their tier.
+func (l *libraryImpl) lockBorrower(ctx context.Context, patronID string) (entity.LoanPolicy, error) {
+	patron, err := l.patronRepository.LockPatron(ctx, patronID)
+
+	switch {
+	case err != nil:
+		return entity.LoanPolicy{}, err
+	case patron.Blocked:
+		return entity.LoanPolicy{}, entity.ErrPatronBlocked
+	case patron.MembershipExpired(time.Now()):
+		return entity.LoanPolicy{}, entity.ErrMembershipExpired
+	}
+
+	return l.loanRepository.GetLoanPolicy(ctx, patron.Tier)
+}
@@ -0,0 +1,421 @@
+package library
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultCirculationUseCase(
+	ctrl *gomock.Controller,
+	patronRepository *mocks.MockPatronRepository,
+	copyRepository *mocks.MockCopyRepository,
+	loanRepository *mocks.MockLoanRepository,
+	holdRepository *mocks.MockHoldRepository,
+	fineRepository *mocks.MockFineRepository,
+	transferRepository *mocks.MockTransferRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		copyRepository, patronRepository, loanRepository, holdRepository, fineRepository,
+		mocks.NewMockBranchRepository(ctrl), transferRepository, mocks.NewMockReviewRepository(ctrl))
+}
+
+var testLoanPolicy = entity.LoanPolicy{Tier: entity.MembershipTierStandard, LoanDays: 21, MaxLoans: 2, MaxRenewals: 1}
+
+var testFinePolicy = entity.FinePolicy{
+	Tier:                entity.MembershipTierStandard,
+	DailyRateCents:      25,
+	GraceDays:           2,
+	MaxFineCents:        2000,
+	BlockThresholdCents: 1000,
+}
+
+func TestCheckoutCopy(t *testing.T) {
+	t.Parallel()
+
+	patron := entity.Patron{
+		ID:        uuid.NewString(),
+		Tier:      entity.MembershipTierStandard,
+		ExpiresOn: time.Now().AddDate(1, 0, 0),
+	}
+	branchID := uuid.NewString()
+	bookCopy := entity.Copy{
+		ID:              uuid.NewString(),
+		BookID:          uuid.NewString(),
+		CurrentBranchID: branchID,
+		Barcode:         "LIB-0001",
+		Status:          entity.CopyStatusAvailable,
+	}
+	loan := entity.Loan{
+		ID:           uuid.NewString(),
+		CopyID:       bookCopy.ID,
+		BookID:       bookCopy.BookID,
+		PatronID:     patron.ID,
+		BranchID:     branchID,
+		CheckedOutAt: time.Now(),
+		DueAt:        time.Now().AddDate(0, 0, testLoanPolicy.LoanDays),
+	}
+	heldCopy := entity.Copy{ID: bookCopy.ID, BookID: bookCopy.BookID, CurrentBranchID: branchID, Status: entity.CopyStatusOnHold}
+	retiredAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		patron        entity.Patron
+		patronError   error
+		fineBalance   int64
+		activeLoans   int
+		copy          entity.Copy
+		copyError     error
+		heldFor       string
+		pickupBranch  string
+		released      string
+		expectedError error
+	}{
+		{
+			name:   "Run without errors",
+			patron: patron,
+			copy:   bookCopy,
+		},
+		{
+			name:          "Run with copy of a deleted book",
+			patron:        patron,
+			copyError:     entity.ErrBookNotFound,
+			expectedError: status.Error(codes.NotFound, "book not found"),
+		},
+		{
+			name:         "Run with copy at the pickup branch",
+			patron:       patron,
+			copy:         bookCopy,
+			pickupBranch: branchID,
+		},
+		{
+			name:          "Run with copy at another branch",
+			patron:        patron,
+			copy:          bookCopy,
+			pickupBranch:  uuid.NewString(),
+			expectedError: status.Error(codes.FailedPrecondition, "copy is at another branch"),
+		},
+		{
+			name:     "Run with another copy held for the patron",
+			patron:   patron,
+			copy:     bookCopy,
+			released: uuid.NewString(),
+		},
+		{
+			name:          "Run with patron not found errors",
+			patronError:   entity.ErrPatronNotFound,
+			expectedError: status.Error(codes.NotFound, "patron not found"),
+		},
+		{
+			name:          "Run with blocked patron",
+			patron:        entity.Patron{ID: patron.ID, Blocked: true, ExpiresOn: patron.ExpiresOn},
+			expectedError: status.Error(codes.FailedPrecondition, "patron is blocked"),
+		},
+		{
+			name:          "Run with expired membership",
+			patron:        entity.Patron{ID: patron.ID, ExpiresOn: time.Now().AddDate(0, 0, -2)},
+			expectedError: status.Error(codes.FailedPrecondition, "membership has expired"),
+		},
+		{
+			name:          "Run with unpaid fines over the limit",
+			patron:        patron,
+			fineBalance:   testFinePolicy.BlockThresholdCents + 1,
+			expectedError: status.Error(codes.FailedPrecondition, "patron has unpaid fines over the limit"),
+		},
+		{
+			name:        "Run with unpaid fines at the limit",
+			patron:      patron,
+			fineBalance: testFinePolicy.BlockThresholdCents,
+			copy:        bookCopy,
+		},
+		{
+			name:          "Run with loan limit reached",
+			patron:        patron,
+			activeLoans:   testLoanPolicy.MaxLoans,
+			expectedError: status.Error(codes.FailedPrecondition, "patron has reached the maximum number of loans"),
+		},
+		{
+			name:          "Run with copy on loan",
+			patron:        patron,
+			copy:          entity.Copy{ID: bookCopy.ID, Status: entity.CopyStatusOnLoan},
+			expectedError: status.Error(codes.FailedPrecondition, "copy is not available"),
+		},
+		{
+			name:    "Run with copy held for the patron",
+			patron:  patron,
+			copy:    heldCopy,
+			heldFor: patron.ID,
+		},
+		{
+			name:          "Run with copy held for another patron",
+			patron:        patron,
+			copy:          heldCopy,
+			heldFor:       uuid.NewString(),
+			expectedError: status.Error(codes.FailedPrecondition, "copy is held for pickup"),
+		},
+		{
+			name:          "Run with retired copy",
+			patron:        patron,
+			copy:          entity.Copy{ID: bookCopy.ID, Status: entity.CopyStatusAvailable, RetiredAt: &retiredAt},
+			expectedError: status.Error(codes.FailedPrecondition, "copy is retired"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().LockPatron(ctx, patron.ID).Return(tc.patron, tc.patronError)
+
+			loanRepo := mocks.NewMockLoanRepository(ctrl)
+			loanRepo.EXPECT().GetLoanPolicy(ctx, gomock.Any()).Return(testLoanPolicy, nil).AnyTimes()
+			loanRepo.EXPECT().CountActiveLoans(ctx, patron.ID).Return(tc.activeLoans, nil).AnyTimes()
+
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().LockCopyByBarcode(ctx, bookCopy.Barcode).Return(tc.copy, tc.copyError).AnyTimes()
+
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().GetFinePolicy(ctx, entity.MembershipTierStandard).Return(testFinePolicy, nil).AnyTimes()
+			fineRepo.EXPECT().GetFineBalance(ctx, patron.ID).Return(tc.fineBalance, nil).AnyTimes()
+
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			if tc.heldFor != "" {
+				holdRepo.EXPECT().LockReadyHoldByCopy(ctx, bookCopy.ID).Return(entity.Hold{PatronID: tc.heldFor}, nil)
+			}
+
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			if tc.expectedError == nil {
+				loanRepo.EXPECT().CreateLoan(ctx, bookCopy.ID, patron.ID, branchID, testLoanPolicy.LoanDays).Return(loan, nil)
+				holdRepo.EXPECT().FulfillHolds(ctx, patron.ID, bookCopy.BookID, bookCopy.ID).Return(tc.released, nil)
+			}
+			if tc.released != "" {
+				transferRepo.EXPECT().CancelPendingTransfer(ctx, tc.released).Return(true, nil)
+				holdRepo.EXPECT().PromoteNextHold(ctx, bookCopy.BookID, tc.released).Return(entity.Hold{}, entity.ErrHoldNotFound)
+			}
+
+			uc := getDefaultCirculationUseCase(ctrl, patronRepo, copyRepo, loanRepo, holdRepo, fineRepo, transferRepo,
+				newPassingTransactor(ctx, ctrl))
+			resp, err := uc.CheckoutCopy(ctx, &library.CheckoutCopyRequest{
+				PatronId:       patron.ID,
+				Barcode:        bookCopy.Barcode,
+				PickupBranchId: tc.pickupBranch,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, loanToProto(loan), resp.GetLoan())
+			require.Equal(t, branchID, resp.GetLoan().GetBranchId())
+			require.Nil(t, resp.GetLoan().GetReturnedAt())
+		})
+	}
+}
+
+func TestReturnCopy(t *testing.T) {
+	t.Parallel()
+
+	returnedAt := time.Now()
+	branchID := uuid.NewString()
+	loan := entity.Loan{
+		ID:         uuid.NewString(),
+		CopyID:     uuid.NewString(),
+		BookID:     uuid.NewString(),
+		PatronID:   uuid.NewString(),
+		ReturnedAt: &returnedAt,
+	}
+
+	fine := entity.Fine{
+		ID:          uuid.NewString(),
+		LoanID:      loan.ID,
+		PatronID:    loan.PatronID,
+		AmountCents: 75,
+		Status:      entity.FineStatusUnpaid,
+		Events:      []entity.FineEvent{{Kind: entity.FineEventAssessed, AmountCents: 75}},
+	}
+
+	testCases := []struct {
+		name          string
+		lockError     error
+		fine          entity.Fine
+		fineError     error
+		expectedError error
+	}{
+		{
+			name:      "Run without errors",
+			fineError: entity.ErrFineNotFound,
+		},
+		{
+			name: "Run with late return",
+			fine: fine,
+		},
+		{
+			name:          "Run with copy not on loan",
+			lockError:     entity.ErrLoanNotFound,
+			expectedError: status.Error(codes.NotFound, "loan not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			loanRepo := mocks.NewMockLoanRepository(ctrl)
+			loanRepo.EXPECT().LockActiveLoanByBarcode(ctx, "LIB-0001").Return(entity.Loan{ID: loan.ID}, tc.lockError)
+
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			if tc.lockError == nil {
+				loanRepo.EXPECT().CloseLoan(ctx, loan.ID, branchID).Return(loan, nil)
+				fineRepo.EXPECT().AssessLoanFine(ctx, loan.ID).Return(tc.fine, tc.fineError)
+				holdRepo.EXPECT().PromoteNextHold(ctx, loan.BookID, loan.CopyID).Return(entity.Hold{}, entity.ErrHoldNotFound)
+			}
+
+			uc := getDefaultCirculationUseCase(ctrl, mocks.NewMockPatronRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+				loanRepo, holdRepo, fineRepo, mocks.NewMockTransferRepository(ctrl), newPassingTransactor(ctx, ctrl))
+			resp, err := uc.ReturnCopy(ctx, &library.ReturnCopyRequest{Barcode: "LIB-0001", BranchId: branchID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.GetLoan().GetReturnedAt())
+			if tc.fineError != nil {
+				require.Nil(t, resp.GetFine())
+			} else {
+				require.Equal(t, fineToProto(tc.fine), resp.GetFine())
+			}
+		})
+	}
+}
+
+func TestRenewLoan(t *testing.T) {
+	t.Parallel()
+
+	patron := entity.Patron{ID: uuid.NewString(), Tier: entity.MembershipTierStandard, ExpiresOn: time.Now().AddDate(1, 0, 0)}
+	dueAt := time.Now().AddDate(0, 0, 7)
+	loan := entity.Loan{ID: uuid.NewString(), CopyID: uuid.NewString(), PatronID: patron.ID, DueAt: dueAt}
+	returnedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		loan          entity.Loan
+		patron        entity.Patron
+		fine          entity.Fine
+		expectedError error
+	}{
+		{
+			name:   "Run without errors",
+			loan:   loan,
+			patron: patron,
+		},
+		{
+			name:          "Run with returned loan",
+			loan:          entity.Loan{ID: loan.ID, PatronID: patron.ID, ReturnedAt: &returnedAt},
+			expectedError: status.Error(codes.FailedPrecondition, "loan is already returned"),
+		},
+		{
+			name:          "Run with renewal limit reached",
+			loan:          entity.Loan{ID: loan.ID, PatronID: patron.ID, DueAt: dueAt, Renewals: testLoanPolicy.MaxRenewals},
+			patron:        patron,
+			expectedError: status.Error(codes.FailedPrecondition, "loan has reached the maximum number of renewals"),
+		},
+		{
+			name:          "Run with overdue loan not assessed yet",
+			loan:          entity.Loan{ID: loan.ID, PatronID: patron.ID, DueAt: time.Now().AddDate(0, 0, -1)},
+			patron:        patron,
+			expectedError: status.Error(codes.FailedPrecondition, "loan is overdue"),
+		},
+		{
+			name:          "Run with accruing fine",
+			loan:          loan,
+			patron:        patron,
+			fine:          entity.Fine{LoanID: loan.ID, AmountCents: 50, Status: entity.FineStatusAccruing},
+			expectedError: status.Error(codes.FailedPrecondition, "loan has an accruing fine"),
+		},
+		{
+			name:   "Run with paid fine",
+			loan:   loan,
+			patron: patron,
+			fine:   entity.Fine{LoanID: loan.ID, AmountCents: 50, PaidCents: 50, Status: entity.FineStatusPaid},
+		},
+		{
+			name:          "Run with blocked patron",
+			loan:          loan,
+			patron:        entity.Patron{ID: patron.ID, Blocked: true, ExpiresOn: patron.ExpiresOn},
+			expectedError: status.Error(codes.FailedPrecondition, "patron is blocked"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			loanRepo := mocks.NewMockLoanRepository(ctrl)
+			loanRepo.EXPECT().LockLoan(ctx, loan.ID).Return(tc.loan, nil)
+			loanRepo.EXPECT().GetLoanPolicy(ctx, entity.MembershipTierStandard).Return(testLoanPolicy, nil).AnyTimes()
+
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().LockPatron(ctx, patron.ID).Return(tc.patron, nil).AnyTimes()
+
+			var fineErr error
+			if tc.fine.LoanID == "" {
+				fineErr = entity.ErrFineNotFound
+			}
+
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().GetLoanFine(ctx, loan.ID).Return(tc.fine, fineErr).MaxTimes(1)
+
+			renewed := loan
+			renewed.Renewals = 1
+			if tc.expectedError == nil {
+				loanRepo.EXPECT().RenewLoan(ctx, loan.ID, testLoanPolicy.LoanDays).Return(renewed, nil)
+			}
+
+			uc := getDefaultCirculationUseCase(ctrl, patronRepo, mocks.NewMockCopyRepository(ctrl), loanRepo,
+				mocks.NewMockHoldRepository(ctrl), fineRepo, mocks.NewMockTransferRepository(ctrl),
+				newPassingTransactor(ctx, ctrl))
+			resp, err := uc.RenewLoan(ctx, &library.RenewLoanRequest{Id: loan.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int32(1), resp.GetLoan().GetRenewals())
+		})
+	}
+}
+
+func TestMembershipExpired(t *testing.T) {
+	t.Parallel()
+
+	patron := entity.Patron{ExpiresOn: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)}
+
+	require.False(t, patron.MembershipExpired(time.Date(2026, time.October, 16, 23, 59, 0, 0, time.UTC)))
+	require.True(t, patron.MembershipExpired(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,89 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) AddCopy(ctx context.Context, request *library.AddCopyRequest) (*library.AddCopyResponse, error) {
+	l.logger.Info("Add copy request is being made to the database.")
+	bookCopy, err := l.copyRepository.AddCopy(ctx, entity.Copy{
+		BookID:        request.GetBookId(),
+		Barcode:       request.GetBarcode(),
+		ShelfLocation: request.GetShelfLocation(),
+		AcquiredOn:    timeFromProto(request.GetAcquiredOn()),
+		PriceCents:    request.GetPriceCents(),
+		HomeBranchID:  request.GetBranchId(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.AddCopyResponse{
+		Copy: copyToProto(bookCopy),
+	}, nil
+}
+
+func (l *libraryImpl) GetCopyByBarcode(ctx context.Context, request *library.GetCopyByBarcodeRequest) (*library.GetCopyByBarcodeResponse, error) {
+	l.logger.Info("Get copy by barcode request is being made to the database.")
+	bookCopy, err := l.copyRepository.GetCopyByBarcode(ctx, request.GetBarcode())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.GetCopyByBarcodeResponse{
+		Copy: copyToProto(bookCopy),
+	}, nil
+}
+
+func (l *libraryImpl) SetCopyStatus(ctx context.Context, request *library.SetCopyStatusRequest) (*library.SetCopyStatusResponse, error) {
+	var bookCopy entity.Copy
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Set copy status request is being made to the database.")
+
+		var txErr error
+		bookCopy, txErr = l.copyRepository.SetCopyStatus(ctx, request.GetId(), entity.CopyStatus(request.GetStatus()))
+
+		if txErr != nil || bookCopy.Status != entity.CopyStatusAvailable {
+			return txErr
+		}
+
+		_, txErr = l.promoteNextHold(ctx, bookCopy.BookID, bookCopy.ID)
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.SetCopyStatusResponse{
+		Copy: copyToProto(bookCopy),
+	}, nil
+}
+
+func (l *libraryImpl) RetireCopy(ctx context.Context, request *library.RetireCopyRequest) (*library.RetireCopyResponse, error) {
+	var bookCopy entity.Copy
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Retire copy request is being made to the database.")
+
+		var txErr error
+		bookCopy, txErr = l.copyRepository.RetireCopy(ctx, request.GetId())
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RetireCopyResponse{
+		Copy: copyToProto(bookCopy),
+	}, nil
+}
@@ -0,0 +1,258 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getDefaultCopyUseCase(
+	ctrl *gomock.Controller,
+	copyRepository *mocks.MockCopyRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		copyRepository, mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestAddCopy(t *testing.T) {
+	t.Parallel()
+
+	acquiredOn := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	branchID := uuid.NewString()
+	bookCopy := entity.Copy{
+		ID:              uuid.NewString(),
+		BookID:          uuid.NewString(),
+		HomeBranchID:    branchID,
+		CurrentBranchID: branchID,
+		Barcode:         "LIB-0001",
+		ShelfLocation:   "A-12",
+		AcquiredOn:      &acquiredOn,
+		PriceCents:      1999,
+		Status:          entity.CopyStatusAvailable,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with book not found errors",
+			repositoryError: entity.ErrBookNotFound,
+			expectedError:   status.Error(codes.NotFound, "book not found"),
+		},
+		{
+			name:            "Run with duplicate barcode errors",
+			repositoryError: entity.ErrCopyBarcodeExists,
+			expectedError:   status.Error(codes.AlreadyExists, "copy with this barcode already exists"),
+		},
+		{
+			name:            "Run with branch not found errors",
+			repositoryError: entity.ErrBranchNotFound,
+			expectedError:   status.Error(codes.NotFound, "branch not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().AddCopy(ctx, entity.Copy{
+				BookID:        bookCopy.BookID,
+				Barcode:       bookCopy.Barcode,
+				ShelfLocation: bookCopy.ShelfLocation,
+				AcquiredOn:    &acquiredOn,
+				PriceCents:    bookCopy.PriceCents,
+				HomeBranchID:  branchID,
+			}).Return(bookCopy, tc.repositoryError)
+
+			uc := getDefaultCopyUseCase(ctrl, copyRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.AddCopy(ctx, &library.AddCopyRequest{
+				BookId:        bookCopy.BookID,
+				Barcode:       bookCopy.Barcode,
+				ShelfLocation: bookCopy.ShelfLocation,
+				AcquiredOn:    timestamppb.New(acquiredOn),
+				PriceCents:    bookCopy.PriceCents,
+				BranchId:      branchID,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, copyToProto(bookCopy), resp.GetCopy())
+			require.Nil(t, resp.GetCopy().GetRetiredAt())
+		})
+	}
+}
+
+func TestGetCopyByBarcode(t *testing.T) {
+	t.Parallel()
+
+	bookCopy := entity.Copy{ID: uuid.NewString(), BookID: uuid.NewString(), Barcode: "LIB-0001", Status: entity.CopyStatusLost}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrCopyNotFound,
+			expectedError:   status.Error(codes.NotFound, "copy not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().GetCopyByBarcode(ctx, bookCopy.Barcode).Return(bookCopy, tc.repositoryError)
+
+			uc := getDefaultCopyUseCase(ctrl, copyRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.GetCopyByBarcode(ctx, &library.GetCopyByBarcodeRequest{Barcode: bookCopy.Barcode})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.CopyStatus_COPY_STATUS_LOST, resp.GetCopy().GetStatus())
+		})
+	}
+}
+
+func TestSetCopyStatus(t *testing.T) {
+	t.Parallel()
+
+	bookCopy := entity.Copy{ID: uuid.NewString(), BookID: uuid.NewString(), Barcode: "LIB-0001", Status: entity.CopyStatusDamaged}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with on loan errors",
+			repositoryError: entity.ErrCopyOnLoan,
+			expectedError:   status.Error(codes.FailedPrecondition, "copy is on loan"),
+		},
+		{
+			name:            "Run with retired errors",
+			repositoryError: entity.ErrCopyRetired,
+			expectedError:   status.Error(codes.FailedPrecondition, "copy is retired"),
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().SetCopyStatus(ctx, bookCopy.ID, entity.CopyStatusDamaged).Return(bookCopy, tc.repositoryError)
+
+			uc := getDefaultCopyUseCase(ctrl, copyRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.SetCopyStatus(ctx, &library.SetCopyStatusRequest{
+				Id:     bookCopy.ID,
+				Status: library.CopyStatus_COPY_STATUS_DAMAGED,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, copyToProto(bookCopy), resp.GetCopy())
+		})
+	}
+}
+
+func TestRetireCopy(t *testing.T) {
+	t.Parallel()
+
+	retiredAt := time.Now()
+	bookCopy := entity.Copy{ID: uuid.NewString(), BookID: uuid.NewString(), Barcode: "LIB-0001", RetiredAt: &retiredAt}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrCopyNotFound,
+			expectedError:   status.Error(codes.NotFound, "copy not found"),
+		},
+		{
+			name:            "Run with on loan errors",
+			repositoryError: entity.ErrCopyOnLoan,
+			expectedError:   status.Error(codes.FailedPrecondition, "copy is on loan"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().RetireCopy(ctx, bookCopy.ID).Return(bookCopy, tc.repositoryError)
+
+			uc := getDefaultCopyUseCase(ctrl, copyRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.RetireCopy(ctx, &library.RetireCopyRequest{Id: bookCopy.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, timestamppb.New(retiredAt), resp.GetCopy().GetRetiredAt())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) ListFines(ctx context.Context, request *library.ListFinesRequest) (*library.ListFinesResponse, error) {
+	l.logger.Info("List fines request is being made to the database.")
+	fines, err := l.fineRepository.ListFines(ctx, request.GetPatronId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListFinesResponse{
+		Fines: make([]*library.Fine, 0, len(fines)),
+	}
+
+	for _, fine := range fines {
+		response.Fines = append(response.Fines, fineToProto(fine))
+		response.BalanceCents += fine.OutstandingCents()
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) PayFine(ctx context.Context, request *library.PayFineRequest) (*library.PayFineResponse, error) {
+	var fine entity.Fine
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Pay fine request is being made to the database.")
+
+		current, txErr := l.lockOpenFine(ctx, request.GetId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case request.GetAmountCents() > current.OutstandingCents():
+			return entity.ErrFineOverpaid
+		}
+
+		fine, txErr = l.fineRepository.PayFine(ctx, current.ID, request.GetAmountCents())
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.PayFineResponse{
+		Fine: fineToProto(fine),
+	}, nil
+}
+
+func (l *libraryImpl) WaiveFine(ctx context.Context, request *library.WaiveFineRequest) (*library.WaiveFineResponse, error) {
+	var fine entity.Fine
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Waive fine request is being made to the database.")
+
+		current, txErr := l.lockOpenFine(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		fine, txErr = l.fineRepository.WaiveFine(ctx, current.ID, request.GetReason())
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.WaiveFineResponse{
+		Fine: fineToProto(fine),
+	}, nil
+}
+
+func (l *libraryImpl) lockOpenFine(ctx context.Context, id string) (entity.Fine, error) {
+	fine, err := l.fineRepository.LockFine(ctx, id)
+
+	switch {
+	case err != nil:
+		return entity.Fine{}, err
+	case !fine.Open():
+		return entity.Fine{}, entity.ErrFineClosed
+	}
+
+	return fine, nil
+}
+
+// checkFineBalance checks that unpaid fines of the patron do not exceed the threshold of their tier.
+func (l *libraryImpl) checkFineBalance(ctx context.Context, patronID string, tier entity.MembershipTier) error {
+	policy, err := l.fineRepository.GetFinePolicy(ctx, tier)
+
+	if err != nil {
+		return err
+	}
+
+	balance, err := l.fineRepository.GetFineBalance(ctx, patronID)
+
+	switch {
+	case err != nil:
+		return err
+	case balance > policy.BlockThresholdCents:
+		return entity.ErrFineBalanceExceeded
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultFineUseCase(
+	ctrl *gomock.Controller,
+	fineRepository *mocks.MockFineRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		mocks.NewMockCopyRepository(ctrl), mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), fineRepository,
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestListFines(t *testing.T) {
+	t.Parallel()
+
+	patronID := uuid.NewString()
+	fines := []entity.Fine{
+		{ID: uuid.NewString(), PatronID: patronID, AmountCents: 300, PaidCents: 100, Status: entity.FineStatusUnpaid},
+		{ID: uuid.NewString(), PatronID: patronID, AmountCents: 50, Status: entity.FineStatusAccruing},
+		{ID: uuid.NewString(), PatronID: patronID, AmountCents: 500, PaidCents: 500, Status: entity.FineStatusPaid},
+		{ID: uuid.NewString(), PatronID: patronID, AmountCents: 400, Status: entity.FineStatusWaived},
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().ListFines(ctx, patronID).Return(fines, tc.repositoryError)
+
+			uc := getDefaultFineUseCase(ctrl, fineRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.ListFines(ctx, &library.ListFinesRequest{PatronId: patronID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetFines(), len(fines))
+			require.Equal(t, int64(250), resp.GetBalanceCents())
+		})
+	}
+}
+
+func TestPayFine(t *testing.T) {
+	t.Parallel()
+
+	fine := entity.Fine{ID: uuid.NewString(), AmountCents: 300, PaidCents: 100, Status: entity.FineStatusUnpaid}
+
+	testCases := []struct {
+		name          string
+		fine          entity.Fine
+		lockError     error
+		amountCents   int64
+		expectedError error
+	}{
+		{
+			name:        "Run without errors",
+			fine:        fine,
+			amountCents: 200,
+		},
+		{
+			name:          "Run with fine not found errors",
+			lockError:     entity.ErrFineNotFound,
+			amountCents:   200,
+			expectedError: status.Error(codes.NotFound, "fine not found"),
+		},
+		{
+			name:          "Run with overpayment",
+			fine:          fine,
+			amountCents:   201,
+			expectedError: status.Error(codes.InvalidArgument, "payment exceeds the outstanding amount"),
+		},
+		{
+			name:          "Run with waived fine",
+			fine:          entity.Fine{ID: fine.ID, AmountCents: 300, Status: entity.FineStatusWaived},
+			amountCents:   100,
+			expectedError: status.Error(codes.FailedPrecondition, "fine is already paid or waived"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().LockFine(ctx, fine.ID).Return(tc.fine, tc.lockError)
+
+			paid := fine
+			paid.PaidCents = fine.AmountCents
+			paid.Status = entity.FineStatusPaid
+			if tc.expectedError == nil {
+				fineRepo.EXPECT().PayFine(ctx, fine.ID, tc.amountCents).Return(paid, nil)
+			}
+
+			uc := getDefaultFineUseCase(ctrl, fineRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.PayFine(ctx, &library.PayFineRequest{Id: fine.ID, AmountCents: tc.amountCents})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.FineStatus_FINE_STATUS_PAID, resp.GetFine().GetStatus())
+		})
+	}
+}
+
+func TestWaiveFine(t *testing.T) {
+	t.Parallel()
+
+	fine := entity.Fine{ID: uuid.NewString(), AmountCents: 300, Status: entity.FineStatusAccruing}
+
+	testCases := []struct {
+		name          string
+		fine          entity.Fine
+		expectedError error
+	}{
+		{
+			name: "Run without errors",
+			fine: fine,
+		},
+		{
+			name:          "Run with paid fine",
+			fine:          entity.Fine{ID: fine.ID, AmountCents: 300, PaidCents: 300, Status: entity.FineStatusPaid},
+			expectedError: status.Error(codes.FailedPrecondition, "fine is already paid or waived"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			fineRepo := mocks.NewMockFineRepository(ctrl)
+			fineRepo.EXPECT().LockFine(ctx, fine.ID).Return(tc.fine, nil)
+
+			waived := fine
+			waived.Status = entity.FineStatusWaived
+			waived.Events = []entity.FineEvent{{Kind: entity.FineEventWaived, AmountCents: 300, Note: "lost in mail"}}
+			if tc.expectedError == nil {
+				fineRepo.EXPECT().WaiveFine(ctx, fine.ID, "lost in mail").Return(waived, nil)
+			}
+
+			uc := getDefaultFineUseCase(ctrl, fineRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.WaiveFine(ctx, &library.WaiveFineRequest{Id: fine.ID, Reason: "lost in mail"})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, fineToProto(waived), resp.GetFine())
+		})
+	}
+}
+
+func TestFineOutstandingCents(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, int64(200), entity.Fine{AmountCents: 300, PaidCents: 100, Status: entity.FineStatusUnpaid}.OutstandingCents())
+	require.Equal(t, int64(0), entity.Fine{AmountCents: 300, PaidCents: 100, Status: entity.FineStatusWaived}.OutstandingCents())
+}
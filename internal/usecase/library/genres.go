@@ -0,0 +1,123 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) CreateGenre(ctx context.Context, request *library.CreateGenreRequest) (*library.CreateGenreResponse, error) {
+	l.logger.Info("Create genre request is being made to the database.")
+	genre, err := l.genreRepository.CreateGenre(ctx, entity.Genre{
+		Name:     request.GetName(),
+		ParentID: request.GetParentId(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.CreateGenreResponse{
+		Genre: genreToProto(genre),
+	}, nil
+}
+
+func (l *libraryImpl) UpdateGenre(ctx context.Context, request *library.UpdateGenreRequest) (*library.UpdateGenreResponse, error) {
+	paths, err := getMaskPaths(request.GetUpdateMask(), "name", "parent_id")
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	update := entity.GenreUpdate{
+		ID: request.GetId(),
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			name := request.GetName()
+			update.Name = &name
+		case "parent_id":
+			parentID := request.GetParentId()
+			update.ParentID = &parentID
+		}
+	}
+
+	l.logger.Info("Update genre request is being made to the database.")
+	genre, err := l.genreRepository.UpdateGenre(ctx, update)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.UpdateGenreResponse{
+		Genre: genreToProto(genre),
+	}, nil
+}
+
+func (l *libraryImpl) DeleteGenre(ctx context.Context, request *library.DeleteGenreRequest) (*library.DeleteGenreResponse, error) {
+	l.logger.Info("Delete genre request is being made to the database.")
+
+	if err := l.genreRepository.DeleteGenre(ctx, request.GetId()); err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.DeleteGenreResponse{}, nil
+}
+
+func (l *libraryImpl) ListGenres(ctx context.Context, request *library.ListGenresRequest) (*library.ListGenresResponse, error) {
+	l.logger.Info("List genres request is being made to the database.")
+	genres, err := l.genreRepository.ListGenres(ctx, request.GetRootId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListGenresResponse{
+		Genres: make([]*library.Genre, 0, len(genres)),
+	}
+
+	for _, genre := range genres {
+		response.Genres = append(response.Genres, genreToProto(genre))
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) ListBooksByGenre(ctx context.Context, request *library.ListBooksByGenreRequest) (*library.ListBooksByGenreResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	params := entity.ListBooksParams{
+		Filter: entity.BookFilter{
+			GenreID:          request.GetGenreId(),
+			IncludeSubgenres: request.GetIncludeSubgenres(),
+		},
+		OrderBy:    bookOrderFromProto(request.GetOrderBy()),
+		Descending: request.GetDescending(),
+		Limit:      pageSize + 1,
+	}
+
+	if request.GetPageToken() != "" {
+		if err := setBookPageToken(request.GetPageToken(), &params); err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	l.logger.Info("List books by genre request is being made to the database.")
+	books, err := l.genreRepository.ListGenreBooks(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListBooksByGenreResponse{}
+	response.Books, response.NextPageToken, err = getBooksPage(params, pageSize, books)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,291 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func getDefaultGenreUseCase(ctrl *gomock.Controller, genreRepository *mocks.MockGenreRepository) *libraryImpl {
+	return New(zap.NewNop(), mocks.NewMockTransactor(ctrl), mocks.NewMockOutboxRepository(ctrl),
+		mocks.NewMockAuthorRepository(ctrl), mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl),
+		mocks.NewMockPublisherRepository(ctrl), genreRepository, mocks.NewMockSeriesRepository(ctrl),
+		mocks.NewMockWorkRepository(ctrl), mocks.NewMockCopyRepository(ctrl), mocks.NewMockPatronRepository(ctrl),
+		mocks.NewMockLoanRepository(ctrl), mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestCreateGenre(t *testing.T) {
+	t.Parallel()
+
+	parentID := uuid.NewString()
+	genre := entity.Genre{ID: uuid.NewString(), Name: "Fantasy", ParentID: parentID}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with duplicate name",
+			repositoryError: entity.ErrGenreExists,
+			expectedError:   status.Error(codes.AlreadyExists, "genre with this name already exists under the parent"),
+		},
+		{
+			name:            "Run with unknown parent",
+			repositoryError: entity.ErrGenreNotFound,
+			expectedError:   status.Error(codes.NotFound, "genre not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			genreRepo.EXPECT().CreateGenre(ctx, entity.Genre{Name: genre.Name, ParentID: parentID}).
+				Return(genre, tc.repositoryError)
+
+			uc := getDefaultGenreUseCase(ctrl, genreRepo)
+			resp, err := uc.CreateGenre(ctx, &library.CreateGenreRequest{Name: genre.Name, ParentId: parentID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, genreToProto(genre), resp.GetGenre())
+		})
+	}
+}
+
+func TestUpdateGenre(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+	name := "Fantasy"
+	parentID := uuid.NewString()
+	root := ""
+
+	testCases := []struct {
+		name            string
+		request         *library.UpdateGenreRequest
+		expectedUpdate  entity.GenreUpdate
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name:           "Run without errors",
+			request:        &library.UpdateGenreRequest{Id: id, Name: name, ParentId: parentID},
+			expectedUpdate: entity.GenreUpdate{ID: id, Name: &name, ParentID: &parentID},
+		},
+		{
+			name: "Run with move to root",
+			request: &library.UpdateGenreRequest{
+				Id:         id,
+				Name:       name,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+			},
+			expectedUpdate: entity.GenreUpdate{ID: id, ParentID: &root},
+		},
+		{
+			name: "Run with unsupported mask path",
+			request: &library.UpdateGenreRequest{
+				Id:         id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid update mask"),
+		},
+		{
+			name:            "Run with cycle",
+			request:         &library.UpdateGenreRequest{Id: id, Name: name, ParentId: parentID},
+			expectedUpdate:  entity.GenreUpdate{ID: id, Name: &name, ParentID: &parentID},
+			repositoryError: entity.ErrGenreCycle,
+			expectedError:   status.Error(codes.FailedPrecondition, "genre can not be moved under itself or its subgenre"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			genre := entity.Genre{ID: id, Name: name}
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			genreRepo.EXPECT().UpdateGenre(ctx, tc.expectedUpdate).Return(genre, tc.repositoryError).AnyTimes()
+
+			uc := getDefaultGenreUseCase(ctrl, genreRepo)
+			resp, err := uc.UpdateGenre(ctx, tc.request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, genreToProto(genre), resp.GetGenre())
+		})
+	}
+}
+
+func TestDeleteGenre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedCode    codes.Code
+	}{
+		{
+			name:         "Run without errors",
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "Run with subgenres",
+			repositoryError: entity.ErrGenreHasChildren,
+			expectedCode:    codes.FailedPrecondition,
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrGenreNotFound,
+			expectedCode:    codes.NotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			id := uuid.NewString()
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			genreRepo.EXPECT().DeleteGenre(ctx, id).Return(tc.repositoryError)
+
+			uc := getDefaultGenreUseCase(ctrl, genreRepo)
+			_, err := uc.DeleteGenre(ctx, &library.DeleteGenreRequest{Id: id})
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestListGenres(t *testing.T) {
+	t.Parallel()
+
+	rootID := uuid.NewString()
+	genres := []entity.Genre{
+		{ID: rootID, Name: "Fiction"},
+		{ID: uuid.NewString(), Name: "Fantasy", ParentID: rootID},
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			genreRepo.EXPECT().ListGenres(ctx, rootID).Return(genres, tc.repositoryError)
+
+			uc := getDefaultGenreUseCase(ctrl, genreRepo)
+			resp, err := uc.ListGenres(ctx, &library.ListGenresRequest{RootId: rootID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetGenres(), len(genres))
+			require.Equal(t, rootID, resp.GetGenres()[1].GetParentId())
+		})
+	}
+}
+
+func TestListBooksByGenre(t *testing.T) {
+	t.Parallel()
+
+	genreID := uuid.NewString()
+	books := []entity.Book{
+		{ID: uuid.NewString(), Name: "A", GenreIDs: []string{genreID}},
+		{ID: uuid.NewString(), Name: "B", GenreIDs: []string{uuid.NewString()}},
+	}
+
+	testCases := []struct {
+		name             string
+		includeSubgenres bool
+		repositoryError  error
+		expectedError    error
+	}{
+		{
+			name: "Run without subgenres",
+		},
+		{
+			name:             "Run with subgenres",
+			includeSubgenres: true,
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrGenreNotFound,
+			expectedError:   status.Error(codes.NotFound, "genre not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			genreRepo := mocks.NewMockGenreRepository(ctrl)
+			genreRepo.EXPECT().ListGenreBooks(ctx, entity.ListBooksParams{
+				Filter: entity.BookFilter{GenreID: genreID, IncludeSubgenres: tc.includeSubgenres},
+				Limit:  defaultPageSize + 1,
+			}).Return(books, tc.repositoryError)
+
+			uc := getDefaultGenreUseCase(ctrl, genreRepo)
+			resp, err := uc.ListBooksByGenre(ctx, &library.ListBooksByGenreRequest{
+				GenreId:          genreID,
+				IncludeSubgenres: tc.includeSubgenres,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetBooks(), len(books))
+			require.Empty(t, resp.GetNextPageToken())
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) PlaceHold(ctx context.Context, request *library.PlaceHoldRequest) (*library.PlaceHoldResponse, error) {
+	var hold entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Place hold request is being made to the database.")
+
+		if _, txErr := l.lockBorrower(ctx, request.GetPatronId()); txErr != nil {
+			return txErr
+		}
+
+		counts, txErr := l.copyRepository.GetBranchCopyCounts(ctx, request.GetBookId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		availableElsewhere := false
+		for _, branch := range counts {
+			switch {
+			case branch.Available == 0:
+			case branch.BranchID == request.GetPickupBranchId():
+				return entity.ErrHoldNotNeeded
+			default:
+				availableElsewhere = true
+			}
+		}
+
+		hold, txErr = l.holdRepository.CreateHold(ctx, request.GetBookId(), request.GetPatronId(),
+			request.GetPickupBranchId())
+
+		if txErr != nil || !availableElsewhere {
+			return txErr
+		}
+
+		// An available copy of another branch is held for the queue right away and sent to the pickup branch.
+		bookCopy, txErr := l.copyRepository.LockAvailableCopy(ctx, request.GetBookId())
+
+		switch {
+		case errors.Is(txErr, entity.ErrCopyNotFound):
+			return nil
+		case txErr != nil:
+			return txErr
+		}
+
+		promoted, txErr := l.promoteNextHold(ctx, request.GetBookId(), bookCopy.ID)
+
+		if promoted.ID == hold.ID {
+			hold = promoted
+		}
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.PlaceHoldResponse{
+		Hold: holdToProto(hold),
+	}, nil
+}
+
+func (l *libraryImpl) CancelHold(ctx context.Context, request *library.CancelHoldRequest) (*library.CancelHoldResponse, error) {
+	var hold entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Cancel hold request is being made to the database.")
+
+		current, txErr := l.holdRepository.LockHold(ctx, request.GetId())
+
+		switch {
+		case txErr != nil:
+			return txErr
+		case !current.Active():
+			return entity.ErrHoldClosed
+		}
+
+		hold, txErr = l.holdRepository.CancelHold(ctx, current.ID)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		switch current.Status {
+		case entity.HoldStatusReady:
+			_, txErr = l.promoteNextHold(ctx, current.BookID, current.CopyID)
+		case entity.HoldStatusInTransit:
+			// A copy that has been shipped goes to the next patron once it is received.
+			var cancelled bool
+			cancelled, txErr = l.transferRepository.CancelPendingTransfer(ctx, current.CopyID)
+
+			if txErr == nil && cancelled {
+				_, txErr = l.promoteNextHold(ctx, current.BookID, current.CopyID)
+			}
+		}
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.CancelHoldResponse{
+		Hold: holdToProto(hold),
+	}, nil
+}
+
+func (l *libraryImpl) ListHolds(ctx context.Context, request *library.ListHoldsRequest) (*library.ListHoldsResponse, error) {
+	l.logger.Info("List holds request is being made to the database.")
+	holds, err := l.holdRepository.ListHolds(ctx, entity.HoldFilter{
+		BookID:   request.GetBookId(),
+		PatronID: request.GetPatronId(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListHoldsResponse{
+		Holds: make([]*library.Hold, 0, len(holds)),
+	}
+
+	for _, hold := range holds {
+		response.Holds = append(response.Holds, holdToProto(hold))
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) ExpireHolds(ctx context.Context) (int, error) {
+	var expired []entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var txErr error
+		expired, txErr = l.holdRepository.ExpireHolds(ctx)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, hold := range expired {
+			if _, txErr = l.promoteNextHold(ctx, hold.BookID, hold.CopyID); txErr != nil {
+				return txErr
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(expired), nil
+}
+
+// promoteNextHold holds the copy that became available for the next patron in the queue of the book. The patron is
+// notified when the copy is at their pickup branch, otherwise the copy is sent there. The copy stays available when
+// nobody is waiting and the zero hold is returned.
+func (l *libraryImpl) promoteNextHold(ctx context.Context, bookID string, copyID string) (entity.Hold, error) {
+	hold, err := l.holdRepository.PromoteNextHold(ctx, bookID, copyID)
+
+	if errors.Is(err, entity.ErrHoldNotFound) {
+		return entity.Hold{}, nil
+	}
+
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	// A transfer requested before the copy was held does not take it away from the patron.
+	if _, err = l.transferRepository.CancelPendingTransfer(ctx, copyID); err != nil {
+		return entity.Hold{}, err
+	}
+
+	if hold.Status == entity.HoldStatusInTransit {
+		_, err = l.transferRepository.CreateTransfer(ctx, copyID, hold.PickupBranchID)
+
+		return hold, err
+	}
+
+	return hold, l.notifyHoldReady(ctx, hold)
+}
+
+func (l *libraryImpl) notifyHoldReady(ctx context.Context, hold entity.Hold) error {
+	serialized, err := json.Marshal(hold)
+
+	if err != nil {
+		return err
+	}
+
+	idempotencyKey := repository.OutboxKindHoldReady.String() + "_" + hold.ID
+
+	return l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindHoldReady, serialized)
+}
@@ -0,0 +1,318 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultHoldUseCase(
+	ctrl *gomock.Controller,
+	outboxRepository *mocks.MockOutboxRepository,
+	patronRepository *mocks.MockPatronRepository,
+	copyRepository *mocks.MockCopyRepository,
+	loanRepository *mocks.MockLoanRepository,
+	holdRepository *mocks.MockHoldRepository,
+	transferRepository *mocks.MockTransferRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, outboxRepository, mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		copyRepository, patronRepository, loanRepository, holdRepository, mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), transferRepository, mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestPlaceHold(t *testing.T) {
+	t.Parallel()
+
+	patron := entity.Patron{ID: uuid.NewString(), Tier: entity.MembershipTierStandard, ExpiresOn: time.Now().AddDate(1, 0, 0)}
+	pickupBranchID := uuid.NewString()
+	otherBranchID := uuid.NewString()
+	hold := entity.Hold{
+		ID:             uuid.NewString(),
+		BookID:         uuid.NewString(),
+		PatronID:       patron.ID,
+		PickupBranchID: pickupBranchID,
+		Status:         entity.HoldStatusWaiting,
+		Position:       2,
+	}
+	sent := entity.Hold{
+		ID:             hold.ID,
+		BookID:         hold.BookID,
+		PatronID:       patron.ID,
+		PickupBranchID: pickupBranchID,
+		CopyID:         uuid.NewString(),
+		Status:         entity.HoldStatusInTransit,
+	}
+
+	testCases := []struct {
+		name          string
+		patron        entity.Patron
+		counts        []entity.BranchCopyCounts
+		createError   error
+		expectedHold  entity.Hold
+		expectedError error
+	}{
+		{
+			name:         "Run without errors",
+			patron:       patron,
+			counts:       []entity.BranchCopyCounts{{BranchID: pickupBranchID, Total: 2}},
+			expectedHold: hold,
+		},
+		{
+			name:   "Run with copies available at another branch",
+			patron: patron,
+			counts: []entity.BranchCopyCounts{
+				{BranchID: pickupBranchID, Total: 2},
+				{BranchID: otherBranchID, Total: 1, Available: 1},
+			},
+			expectedHold: sent,
+		},
+		{
+			name:          "Run with blocked patron",
+			patron:        entity.Patron{ID: patron.ID, Blocked: true, ExpiresOn: patron.ExpiresOn},
+			expectedError: status.Error(codes.FailedPrecondition, "patron is blocked"),
+		},
+		{
+			name:          "Run with available copies",
+			patron:        patron,
+			counts:        []entity.BranchCopyCounts{{BranchID: pickupBranchID, Total: 2, Available: 1}},
+			expectedError: status.Error(codes.FailedPrecondition, "book has available copies"),
+		},
+		{
+			name:          "Run with hold exists errors",
+			patron:        patron,
+			createError:   entity.ErrHoldExists,
+			expectedError: status.Error(codes.AlreadyExists, "patron already has a hold on this book"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().LockPatron(ctx, patron.ID).Return(tc.patron, nil)
+
+			loanRepo := mocks.NewMockLoanRepository(ctrl)
+			loanRepo.EXPECT().GetLoanPolicy(ctx, entity.MembershipTierStandard).Return(testLoanPolicy, nil).AnyTimes()
+
+			copyRepo := mocks.NewMockCopyRepository(ctrl)
+			copyRepo.EXPECT().GetBranchCopyCounts(ctx, hold.BookID).Return(tc.counts, nil).AnyTimes()
+
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			holdRepo.EXPECT().CreateHold(ctx, hold.BookID, patron.ID, pickupBranchID).Return(hold, tc.createError).AnyTimes()
+
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			if tc.expectedHold.Status == entity.HoldStatusInTransit {
+				copyRepo.EXPECT().LockAvailableCopy(ctx, hold.BookID).Return(entity.Copy{ID: sent.CopyID}, nil)
+				holdRepo.EXPECT().PromoteNextHold(ctx, hold.BookID, sent.CopyID).Return(sent, nil)
+				transferRepo.EXPECT().CancelPendingTransfer(ctx, sent.CopyID).Return(false, nil)
+				transferRepo.EXPECT().CreateTransfer(ctx, sent.CopyID, pickupBranchID).Return(entity.Transfer{}, nil)
+			}
+
+			uc := getDefaultHoldUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), patronRepo, copyRepo, loanRepo,
+				holdRepo, transferRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.PlaceHold(ctx, &library.PlaceHoldRequest{
+				PatronId:       patron.ID,
+				BookId:         hold.BookID,
+				PickupBranchId: pickupBranchID,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, holdToProto(tc.expectedHold), resp.GetHold())
+		})
+	}
+}
+
+func TestCancelHold(t *testing.T) {
+	t.Parallel()
+
+	readyUntil := time.Now().AddDate(0, 0, 7)
+	waiting := entity.Hold{ID: uuid.NewString(), BookID: uuid.NewString(), PatronID: uuid.NewString(),
+		Status: entity.HoldStatusWaiting}
+	ready := entity.Hold{ID: waiting.ID, BookID: waiting.BookID, PatronID: waiting.PatronID, CopyID: uuid.NewString(),
+		Status: entity.HoldStatusReady, ReadyUntil: &readyUntil}
+	inTransit := entity.Hold{ID: waiting.ID, BookID: waiting.BookID, PatronID: waiting.PatronID, CopyID: ready.CopyID,
+		Status: entity.HoldStatusInTransit}
+	next := entity.Hold{ID: uuid.NewString(), BookID: waiting.BookID, PatronID: uuid.NewString(), CopyID: ready.CopyID,
+		Status: entity.HoldStatusReady, ReadyUntil: &readyUntil}
+
+	testCases := []struct {
+		name          string
+		hold          entity.Hold
+		shipped       bool
+		next          *entity.Hold
+		expectedError error
+	}{
+		{
+			name: "Run with waiting hold",
+			hold: waiting,
+		},
+		{
+			name: "Run with ready hold",
+			hold: ready,
+			next: &next,
+		},
+		{
+			name: "Run with copy not shipped yet",
+			hold: inTransit,
+			next: &next,
+		},
+		{
+			name:    "Run with shipped copy",
+			hold:    inTransit,
+			shipped: true,
+		},
+		{
+			name:          "Run with closed hold",
+			hold:          entity.Hold{ID: waiting.ID, Status: entity.HoldStatusFulfilled},
+			expectedError: status.Error(codes.FailedPrecondition, "hold is no longer active"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			cancelled := tc.hold
+			cancelled.Status = entity.HoldStatusCancelled
+
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			holdRepo.EXPECT().LockHold(ctx, waiting.ID).Return(tc.hold, nil)
+
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			transferRepo := mocks.NewMockTransferRepository(ctrl)
+			if tc.expectedError == nil {
+				holdRepo.EXPECT().CancelHold(ctx, waiting.ID).Return(cancelled, nil)
+			}
+			if tc.hold.Status == entity.HoldStatusInTransit {
+				transferRepo.EXPECT().CancelPendingTransfer(ctx, ready.CopyID).Return(!tc.shipped, nil)
+			}
+			if tc.next != nil {
+				holdRepo.EXPECT().PromoteNextHold(ctx, ready.BookID, ready.CopyID).Return(*tc.next, nil)
+				transferRepo.EXPECT().CancelPendingTransfer(ctx, ready.CopyID).Return(false, nil)
+				outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindHoldReady.String()+"_"+next.ID,
+					repository.OutboxKindHoldReady, gomock.Any()).Return(nil)
+			}
+
+			uc := getDefaultHoldUseCase(ctrl, outboxRepo, mocks.NewMockPatronRepository(ctrl),
+				mocks.NewMockCopyRepository(ctrl), mocks.NewMockLoanRepository(ctrl), holdRepo, transferRepo,
+				newPassingTransactor(ctx, ctrl))
+			resp, err := uc.CancelHold(ctx, &library.CancelHoldRequest{Id: waiting.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.HoldStatus_HOLD_STATUS_CANCELLED, resp.GetHold().GetStatus())
+		})
+	}
+}
+
+func TestListHolds(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.NewString()
+	holds := []entity.Hold{
+		{ID: uuid.NewString(), BookID: bookID, PatronID: uuid.NewString(), Status: entity.HoldStatusReady},
+		{ID: uuid.NewString(), BookID: bookID, PatronID: uuid.NewString(), Status: entity.HoldStatusWaiting, Position: 1},
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			holdRepo := mocks.NewMockHoldRepository(ctrl)
+			holdRepo.EXPECT().ListHolds(ctx, entity.HoldFilter{BookID: bookID}).Return(holds, tc.repositoryError)
+
+			uc := getDefaultHoldUseCase(ctrl, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockPatronRepository(ctrl),
+				mocks.NewMockCopyRepository(ctrl), mocks.NewMockLoanRepository(ctrl), holdRepo,
+				mocks.NewMockTransferRepository(ctrl), mocks.NewMockTransactor(ctrl))
+			resp, err := uc.ListHolds(ctx, &library.ListHoldsRequest{BookId: bookID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetHolds(), 2)
+			require.Equal(t, int32(1), resp.GetHolds()[1].GetPosition())
+		})
+	}
+}
+
+func TestExpireHolds(t *testing.T) {
+	t.Parallel()
+
+	expired := []entity.Hold{
+		{ID: uuid.NewString(), BookID: uuid.NewString(), CopyID: uuid.NewString(), Status: entity.HoldStatusExpired},
+		{ID: uuid.NewString(), BookID: uuid.NewString(), CopyID: uuid.NewString(), Status: entity.HoldStatusExpired},
+	}
+	next := entity.Hold{ID: uuid.NewString(), BookID: expired[0].BookID, CopyID: expired[0].CopyID,
+		Status: entity.HoldStatusReady}
+
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+	holdRepo := mocks.NewMockHoldRepository(ctrl)
+	holdRepo.EXPECT().ExpireHolds(ctx).Return(expired, nil)
+	holdRepo.EXPECT().PromoteNextHold(ctx, expired[0].BookID, expired[0].CopyID).Return(next, nil)
+	holdRepo.EXPECT().PromoteNextHold(ctx, expired[1].BookID, expired[1].CopyID).
+		Return(entity.Hold{}, entity.ErrHoldNotFound)
+
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	transferRepo.EXPECT().CancelPendingTransfer(ctx, expired[0].CopyID).Return(false, nil)
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindHoldReady.String()+"_"+next.ID,
+		repository.OutboxKindHoldReady, gomock.Any()).Return(nil)
+
+	uc := getDefaultHoldUseCase(ctrl, outboxRepo, mocks.NewMockPatronRepository(ctrl), mocks.NewMockCopyRepository(ctrl),
+		mocks.NewMockLoanRepository(ctrl), holdRepo, transferRepo, newPassingTransactor(ctx, ctrl))
+	count, err := uc.ExpireHolds(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
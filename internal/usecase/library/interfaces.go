@@ -1,6 +1,6 @@
 package library
 
-//go:generate ../../../bin/mockgen --build_flags=--mod=mod -destination=../../../generated/mocks/use_case_mock.go -package=mocks . AuthorUseCase,BooksUseCase
+//go:generate ../../../bin/mockgen --build_flags=--mod=mod -destination=../../../generated/mocks/use_case_mock.go -package=mocks . AuthorUseCase,BooksUseCase,PublisherUseCase,GenreUseCase,SeriesUseCase,WorkUseCase,CopyUseCase,PatronUseCase,CirculationUseCase,HoldUseCase,HoldExpiryUseCase,FineUseCase,BranchUseCase,ReviewUseCase,ChangesUseCase
 
 import (
 	"context"
@@ -15,25 +15,147 @@ type (
 		RegisterAuthor(ctx context.Context, request *library.RegisterAuthorRequest) (*library.RegisterAuthorResponse, error)
 		ChangeAuthorInfo(ctx context.Context, request *library.ChangeAuthorInfoRequest) (*library.ChangeAuthorInfoResponse, error)
 		GetAuthorInfo(ctx context.Context, request *library.GetAuthorInfoRequest) (*library.GetAuthorInfoResponse, error)
+		BatchGetAuthors(ctx context.Context, request *library.BatchGetAuthorsRequest) (*library.BatchGetAuthorsResponse, error)
+		ListAuthors(ctx context.Context, request *library.ListAuthorsRequest) (*library.ListAuthorsResponse, error)
 		GetAuthorBooks(ctx context.Context, request *library.GetAuthorBooksRequest, resp library.Library_GetAuthorBooksServer) error
+		DeleteAuthor(ctx context.Context, request *library.DeleteAuthorRequest) (*library.DeleteAuthorResponse, error)
+		RestoreAuthor(ctx context.Context, request *library.RestoreAuthorRequest) (*library.RestoreAuthorResponse, error)
+		FindDuplicateAuthors(ctx context.Context, request *library.FindDuplicateAuthorsRequest) (*library.FindDuplicateAuthorsResponse, error)
+		MergeAuthors(ctx context.Context, request *library.MergeAuthorsRequest) (*library.MergeAuthorsResponse, error)
 	}
 
 	BooksUseCase interface {
 		AddBook(ctx context.Context, request *library.AddBookRequest) (*library.AddBookResponse, error)
+		AddBooks(ctx context.Context, requests []*library.AddBookRequest) ([]*library.AddBooksResult, error)
 		UpdateBook(ctx context.Context, request *library.UpdateBookRequest) (*library.UpdateBookResponse, error)
 		GetBookInfo(ctx context.Context, request *library.GetBookInfoRequest) (*library.GetBookInfoResponse, error)
+		GetBookByISBN(ctx context.Context, request *library.GetBookByISBNRequest) (*library.GetBookByISBNResponse, error)
+		BatchGetBooks(ctx context.Context, request *library.BatchGetBooksRequest) (*library.BatchGetBooksResponse, error)
+		ListBooks(ctx context.Context, request *library.ListBooksRequest) (*library.ListBooksResponse, error)
+		SearchCatalog(ctx context.Context, request *library.SearchCatalogRequest) (*library.SearchCatalogResponse, error)
+		DeleteBook(ctx context.Context, request *library.DeleteBookRequest) (*library.DeleteBookResponse, error)
+		RestoreBook(ctx context.Context, request *library.RestoreBookRequest) (*library.RestoreBookResponse, error)
+	}
+
+	PublisherUseCase interface {
+		RegisterPublisher(ctx context.Context, request *library.RegisterPublisherRequest) (*library.RegisterPublisherResponse, error)
+		GetPublisherInfo(ctx context.Context, request *library.GetPublisherInfoRequest) (*library.GetPublisherInfoResponse, error)
+		ListPublisherBooks(ctx context.Context, request *library.ListPublisherBooksRequest) (*library.ListPublisherBooksResponse, error)
+	}
+
+	GenreUseCase interface {
+		CreateGenre(ctx context.Context, request *library.CreateGenreRequest) (*library.CreateGenreResponse, error)
+		UpdateGenre(ctx context.Context, request *library.UpdateGenreRequest) (*library.UpdateGenreResponse, error)
+		DeleteGenre(ctx context.Context, request *library.DeleteGenreRequest) (*library.DeleteGenreResponse, error)
+		ListGenres(ctx context.Context, request *library.ListGenresRequest) (*library.ListGenresResponse, error)
+		ListBooksByGenre(ctx context.Context, request *library.ListBooksByGenreRequest) (*library.ListBooksByGenreResponse, error)
+	}
+
+	SeriesUseCase interface {
+		CreateSeries(ctx context.Context, request *library.CreateSeriesRequest) (*library.CreateSeriesResponse, error)
+		GetSeries(ctx context.Context, request *library.GetSeriesRequest) (*library.GetSeriesResponse, error)
+		SetBookSeries(ctx context.Context, request *library.SetBookSeriesRequest) (*library.SetBookSeriesResponse, error)
+		RemoveBookFromSeries(ctx context.Context, request *library.RemoveBookFromSeriesRequest) (*library.RemoveBookFromSeriesResponse, error)
+		ReorderSeries(ctx context.Context, request *library.ReorderSeriesRequest) (*library.ReorderSeriesResponse, error)
+	}
+
+	WorkUseCase interface {
+		GetWork(ctx context.Context, request *library.GetWorkRequest) (*library.GetWorkResponse, error)
+	}
+
+	CopyUseCase interface {
+		AddCopy(ctx context.Context, request *library.AddCopyRequest) (*library.AddCopyResponse, error)
+		GetCopyByBarcode(ctx context.Context, request *library.GetCopyByBarcodeRequest) (*library.GetCopyByBarcodeResponse, error)
+		SetCopyStatus(ctx context.Context, request *library.SetCopyStatusRequest) (*library.SetCopyStatusResponse, error)
+		RetireCopy(ctx context.Context, request *library.RetireCopyRequest) (*library.RetireCopyResponse, error)
+	}
+
+	PatronUseCase interface {
+		RegisterPatron(ctx context.Context, request *library.RegisterPatronRequest) (*library.RegisterPatronResponse, error)
+		UpdatePatron(ctx context.Context, request *library.UpdatePatronRequest) (*library.UpdatePatronResponse, error)
+		GetPatron(ctx context.Context, request *library.GetPatronRequest) (*library.GetPatronResponse, error)
+		RenewMembership(ctx context.Context, request *library.RenewMembershipRequest) (*library.RenewMembershipResponse, error)
+		BlockPatron(ctx context.Context, request *library.BlockPatronRequest) (*library.BlockPatronResponse, error)
+	}
+
+	CirculationUseCase interface {
+		CheckoutCopy(ctx context.Context, request *library.CheckoutCopyRequest) (*library.CheckoutCopyResponse, error)
+		ReturnCopy(ctx context.Context, request *library.ReturnCopyRequest) (*library.ReturnCopyResponse, error)
+		RenewLoan(ctx context.Context, request *library.RenewLoanRequest) (*library.RenewLoanResponse, error)
+	}
+
+	HoldUseCase interface {
+		PlaceHold(ctx context.Context, request *library.PlaceHoldRequest) (*library.PlaceHoldResponse, error)
+		CancelHold(ctx context.Context, request *library.CancelHoldRequest) (*library.CancelHoldResponse, error)
+		ListHolds(ctx context.Context, request *library.ListHoldsRequest) (*library.ListHoldsResponse, error)
+	}
+
+	// HoldExpiryUseCase expires holds not picked up in time and passes their copies down the queues.
+	HoldExpiryUseCase interface {
+		ExpireHolds(ctx context.Context) (int, error)
+	}
+
+	FineUseCase interface {
+		ListFines(ctx context.Context, request *library.ListFinesRequest) (*library.ListFinesResponse, error)
+		PayFine(ctx context.Context, request *library.PayFineRequest) (*library.PayFineResponse, error)
+		WaiveFine(ctx context.Context, request *library.WaiveFineRequest) (*library.WaiveFineResponse, error)
+	}
+
+	BranchUseCase interface {
+		CreateBranch(ctx context.Context, request *library.CreateBranchRequest) (*library.CreateBranchResponse, error)
+		ListBranches(ctx context.Context, request *library.ListBranchesRequest) (*library.ListBranchesResponse, error)
+		RequestTransfer(ctx context.Context, request *library.RequestTransferRequest) (*library.RequestTransferResponse, error)
+		ShipTransfer(ctx context.Context, request *library.ShipTransferRequest) (*library.ShipTransferResponse, error)
+		ReceiveTransfer(ctx context.Context, request *library.ReceiveTransferRequest) (*library.ReceiveTransferResponse, error)
+		ListTransfers(ctx context.Context, request *library.ListTransfersRequest) (*library.ListTransfersResponse, error)
+	}
+
+	ReviewUseCase interface {
+		SubmitReview(ctx context.Context, request *library.SubmitReviewRequest) (*library.SubmitReviewResponse, error)
+		ListBookReviews(ctx context.Context, request *library.ListBookReviewsRequest) (*library.ListBookReviewsResponse, error)
+		ModerateReview(ctx context.Context, request *library.ModerateReviewRequest) (*library.ModerateReviewResponse, error)
+	}
+
+	ChangesUseCase interface {
+		StreamChanges(ctx context.Context, request *library.StreamChangesRequest, resp library.Library_StreamChangesServer) error
 	}
 )
 
 var _ AuthorUseCase = (*libraryImpl)(nil)
 var _ BooksUseCase = (*libraryImpl)(nil)
+var _ PublisherUseCase = (*libraryImpl)(nil)
+var _ GenreUseCase = (*libraryImpl)(nil)
+var _ SeriesUseCase = (*libraryImpl)(nil)
+var _ WorkUseCase = (*libraryImpl)(nil)
+var _ CopyUseCase = (*libraryImpl)(nil)
+var _ PatronUseCase = (*libraryImpl)(nil)
+var _ CirculationUseCase = (*libraryImpl)(nil)
+var _ HoldUseCase = (*libraryImpl)(nil)
+var _ HoldExpiryUseCase = (*libraryImpl)(nil)
+var _ FineUseCase = (*libraryImpl)(nil)
+var _ BranchUseCase = (*libraryImpl)(nil)
+var _ ReviewUseCase = (*libraryImpl)(nil)
+var _ ChangesUseCase = (*libraryImpl)(nil)
 
 type libraryImpl struct {
-	logger           *zap.Logger
-	transactor       repository.Transactor
-	outboxRepository repository.OutboxRepository
-	authorRepository repository.AuthorRepository
-	booksRepository  repository.BooksRepository
+	logger              *zap.Logger
+	transactor          repository.Transactor
+	outboxRepository    repository.OutboxRepository
+	authorRepository    repository.AuthorRepository
+	booksRepository     repository.BooksRepository
+	changeLogRepository repository.ChangeLogRepository
+	publisherRepository repository.PublisherRepository
+	genreRepository     repository.GenreRepository
+	seriesRepository    repository.SeriesRepository
+	workRepository      repository.WorkRepository
+	copyRepository      repository.CopyRepository
+	patronRepository    repository.PatronRepository
+	loanRepository      repository.LoanRepository
+	holdRepository      repository.HoldRepository
+	fineRepository      repository.FineRepository
+	branchRepository    repository.BranchRepository
+	transferRepository  repository.TransferRepository
+	reviewRepository    repository.ReviewRepository
 }
 
 func New(
@@ -42,12 +164,38 @@ func New(
 	outboxRepository repository.OutboxRepository,
 	authorRepository repository.AuthorRepository,
 	booksRepository repository.BooksRepository,
+	changeLogRepository repository.ChangeLogRepository,
+	publisherRepository repository.PublisherRepository,
+	genreRepository repository.GenreRepository,
+	seriesRepository repository.SeriesRepository,
+	workRepository repository.WorkRepository,
+	copyRepository repository.CopyRepository,
+	patronRepository repository.PatronRepository,
+	loanRepository repository.LoanRepository,
+	holdRepository repository.HoldRepository,
+	fineRepository repository.FineRepository,
+	branchRepository repository.BranchRepository,
+	transferRepository repository.TransferRepository,
+	reviewRepository repository.ReviewRepository,
 ) *libraryImpl {
 	return &libraryImpl{
-		logger:           logger,
-		transactor:       transactor,
-		outboxRepository: outboxRepository,
-		authorRepository: authorRepository,
-		booksRepository:  booksRepository,
+		logger:              logger,
+		transactor:          transactor,
+		outboxRepository:    outboxRepository,
+		authorRepository:    authorRepository,
+		booksRepository:     booksRepository,
+		changeLogRepository: changeLogRepository,
+		publisherRepository: publisherRepository,
+		genreRepository:     genreRepository,
+		seriesRepository:    seriesRepository,
+		workRepository:      workRepository,
+		copyRepository:      copyRepository,
+		patronRepository:    patronRepository,
+		loanRepository:      loanRepository,
+		holdRepository:      holdRepository,
+		fineRepository:      fineRepository,
+		branchRepository:    branchRepository,
+		transferRepository:  transferRepository,
+		reviewRepository:    reviewRepository,
 	}
 }
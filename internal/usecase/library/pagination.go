@@ -0,0 +1,179 @@
+package library
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// pageToken binds the cursor to the query it was issued for, a token is rejected when the filter or the order of
+// the request differ from that query.
+type pageToken struct {
+	Query  string          `json:"q"`
+	Cursor json.RawMessage `json:"p"`
+}
+
+type bookPageToken struct {
+	ID        string    `json:"i"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c"`
+}
+
+type authorPageToken struct {
+	ID   string `json:"i"`
+	Name string `json:"n"`
+}
+
+type reviewPageToken struct {
+	ID        string    `json:"i"`
+	CreatedAt time.Time `json:"c"`
+}
+
+type searchPageToken struct {
+	Score float32                 `json:"s"`
+	Kind  entity.SearchResultKind `json:"k"`
+	ID    string                  `json:"i"`
+}
+
+func getPageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// bookQuery is the filter and order of params, the part of them a book page token is bound to.
+func bookQuery(params entity.ListBooksParams) entity.ListBooksParams {
+	return entity.ListBooksParams{
+		Filter:     params.Filter,
+		OrderBy:    params.OrderBy,
+		Descending: params.Descending,
+	}
+}
+
+// setBookPageToken positions params after the book of the token, which must be issued for the same query.
+func setBookPageToken(raw string, params *entity.ListBooksParams) error {
+	var token bookPageToken
+
+	if err := decodePageToken(raw, bookQuery(*params), &token); err != nil {
+		return err
+	}
+
+	params.After = &entity.BookCursor{
+		ID:        token.ID,
+		Name:      token.Name,
+		CreatedAt: token.CreatedAt,
+	}
+
+	return nil
+}
+
+func getBookPageToken(params entity.ListBooksParams, last entity.Book) (string, error) {
+	return encodePageToken(bookQuery(params), bookPageToken{
+		ID:        last.ID,
+		Name:      last.Name,
+		CreatedAt: last.CreatedAt,
+	})
+}
+
+// getBooksPage drops the extra book fetched to detect the next page and returns the token of that page.
+func getBooksPage(params entity.ListBooksParams, pageSize int, books []entity.Book) ([]*library.Book, string, error) {
+	var nextPageToken string
+
+	if len(books) > pageSize {
+		books = books[:pageSize]
+
+		var err error
+		nextPageToken, err = getBookPageToken(params, books[pageSize-1])
+
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	result := make([]*library.Book, 0, len(books))
+	for _, book := range books {
+		result = append(result, bookToProto(book))
+	}
+
+	return result, nextPageToken, nil
+}
+
+func encodePageToken(query any, cursor any) (string, error) {
+	hash, err := queryHash(query)
+
+	if err != nil {
+		return "", err
+	}
+
+	serialized, err := json.Marshal(cursor)
+
+	if err != nil {
+		return "", err
+	}
+
+	serialized, err = json.Marshal(pageToken{Query: hash, Cursor: serialized})
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(serialized), nil
+}
+
+func decodePageToken(raw string, query any, cursor any) error {
+	serialized, err := base64.RawURLEncoding.DecodeString(raw)
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", entity.ErrInvalidPageToken, err)
+	}
+
+	var token pageToken
+
+	if err = json.Unmarshal(serialized, &token); err != nil {
+		return fmt.Errorf("%w: %w", entity.ErrInvalidPageToken, err)
+	}
+
+	hash, err := queryHash(query)
+
+	if err != nil {
+		return err
+	}
+
+	if token.Query != hash {
+		return fmt.Errorf("%w: issued for another query", entity.ErrInvalidPageToken)
+	}
+
+	if err = json.Unmarshal(token.Cursor, cursor); err != nil {
+		return fmt.Errorf("%w: %w", entity.ErrInvalidPageToken, err)
+	}
+
+	return nil
+}
+
+// queryHash identifies the filter and order of a query, the page size is left out as it may change between pages.
+func queryHash(query any) (string, error) {
+	serialized, err := json.Marshal(query)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(serialized)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
@@ -0,0 +1,173 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) RegisterPatron(ctx context.Context, request *library.RegisterPatronRequest) (*library.RegisterPatronResponse, error) {
+	var patron entity.Patron
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Register patron request is being made to the database.")
+
+		var txErr error
+		patron, txErr = l.patronRepository.RegisterPatron(ctx, entity.Patron{
+			Name:       request.GetName(),
+			Email:      request.GetEmail(),
+			Phone:      request.GetPhone(),
+			CardNumber: request.GetCardNumber(),
+			Tier:       entity.MembershipTier(request.GetTier()),
+		}, int(request.GetMembershipMonths()))
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return l.sendPatronMessage(ctx, patron, repository.OutboxKindPatron.String()+"_"+patron.ID)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RegisterPatronResponse{
+		Patron: patronToProto(patron),
+	}, nil
+}
+
+func (l *libraryImpl) UpdatePatron(ctx context.Context, request *library.UpdatePatronRequest) (*library.UpdatePatronResponse, error) {
+	paths, err := getMaskPaths(request.GetUpdateMask(), "name", "email", "phone", "tier")
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	version, err := parseEtag(request.GetEtag())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	update := entity.PatronUpdate{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			name := request.GetName()
+			update.Name = &name
+		case "email":
+			email := request.GetEmail()
+			update.Email = &email
+		case "phone":
+			phone := request.GetPhone()
+			update.Phone = &phone
+		case "tier":
+			tier := entity.MembershipTier(request.GetTier())
+			update.Tier = &tier
+		}
+	}
+
+	var patron entity.Patron
+
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Update patron request is being made to the database.")
+
+		var txErr error
+		patron, txErr = l.patronRepository.UpdatePatron(ctx, update)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return l.sendPatronMessage(ctx, patron, versionedIdempotencyKey(repository.OutboxKindPatron, patron.ID, patron.Version))
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.UpdatePatronResponse{
+		Patron: patronToProto(patron),
+	}, nil
+}
+
+func (l *libraryImpl) GetPatron(ctx context.Context, request *library.GetPatronRequest) (*library.GetPatronResponse, error) {
+	l.logger.Info("Get patron request is being made to the database.")
+	patron, err := l.patronRepository.GetPatron(ctx, request.GetId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.GetPatronResponse{
+		Patron: patronToProto(patron),
+	}, nil
+}
+
+func (l *libraryImpl) RenewMembership(ctx context.Context, request *library.RenewMembershipRequest) (*library.RenewMembershipResponse, error) {
+	var patron entity.Patron
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Renew membership request is being made to the database.")
+
+		var txErr error
+		patron, txErr = l.patronRepository.RenewMembership(ctx, request.GetId(), int(request.GetMonths()))
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return l.sendPatronMessage(ctx, patron, versionedIdempotencyKey(repository.OutboxKindPatron, patron.ID, patron.Version))
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RenewMembershipResponse{
+		Patron: patronToProto(patron),
+	}, nil
+}
+
+func (l *libraryImpl) BlockPatron(ctx context.Context, request *library.BlockPatronRequest) (*library.BlockPatronResponse, error) {
+	var patron entity.Patron
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Block patron request is being made to the database.")
+
+		var txErr error
+		patron, txErr = l.patronRepository.BlockPatron(ctx, request.GetId(), request.GetBlocked(), request.GetReason())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return l.sendPatronMessage(ctx, patron, versionedIdempotencyKey(repository.OutboxKindPatron, patron.ID, patron.Version))
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.BlockPatronResponse{
+		Patron: patronToProto(patron),
+	}, nil
+}
+
+func (l *libraryImpl) sendPatronMessage(ctx context.Context, patron entity.Patron, idempotencyKey string) error {
+	serialized, err := json.Marshal(patron)
+
+	if err != nil {
+		return err
+	}
+
+	return l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindPatron, serialized)
+}
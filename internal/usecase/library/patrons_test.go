@@ -0,0 +1,370 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func getDefaultPatronUseCase(
+	ctrl *gomock.Controller,
+	patronRepository *mocks.MockPatronRepository,
+	transactor *mocks.MockTransactor,
+	outboxRepository *mocks.MockOutboxRepository,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, outboxRepository, mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		mocks.NewMockCopyRepository(ctrl), patronRepository, mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func newTestPatron() entity.Patron {
+	return entity.Patron{
+		ID:         uuid.NewString(),
+		Name:       "Jane Doe",
+		Email:      "jane@example.com",
+		CardNumber: "P-0001",
+		Tier:       entity.MembershipTierStudent,
+		ExpiresOn:  time.Date(2027, time.October, 16, 0, 0, 0, 0, time.UTC),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Version:    1,
+	}
+}
+
+// expectPatronMessage expects an outbox message when the repository succeeds, prefix is the start of its key.
+func expectPatronMessage(ctx context.Context, ctrl *gomock.Controller, prefix string, err error, times int) *mocks.MockOutboxRepository {
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().SendMessage(ctx, gomock.Cond(func(key string) bool {
+		return strings.HasPrefix(key, prefix)
+	}), repository.OutboxKindPatron, gomock.Any()).Return(err).Times(times)
+
+	return outboxRepo
+}
+
+func TestRegisterPatron(t *testing.T) {
+	t.Parallel()
+
+	patron := newTestPatron()
+	request := &library.RegisterPatronRequest{
+		Name:             patron.Name,
+		Email:            patron.Email,
+		CardNumber:       patron.CardNumber,
+		Tier:             library.MembershipTier_MEMBERSHIP_TIER_STUDENT,
+		MembershipMonths: 12,
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with duplicate card errors",
+			repositoryError: entity.ErrPatronCardExists,
+			expectedError:   status.Error(codes.AlreadyExists, "patron with this card number already exists"),
+		},
+		{
+			name:          "Run with outbox errors",
+			outboxError:   errors.New("test"),
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().RegisterPatron(ctx, entity.Patron{
+				Name:       patron.Name,
+				Email:      patron.Email,
+				CardNumber: patron.CardNumber,
+				Tier:       entity.MembershipTierStudent,
+			}, 12).Return(patron, tc.repositoryError)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindPatron.String()+"_"+patron.ID,
+				repository.OutboxKindPatron, gomock.Any()).Return(tc.outboxError).Times(times)
+
+			uc := getDefaultPatronUseCase(ctrl, patronRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+			resp, err := uc.RegisterPatron(ctx, request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, patronToProto(patron), resp.GetPatron())
+		})
+	}
+}
+
+func TestUpdatePatron(t *testing.T) {
+	t.Parallel()
+
+	patron := newTestPatron()
+	phone := "+15551234567"
+	email := ""
+
+	testCases := []struct {
+		name            string
+		request         *library.UpdatePatronRequest
+		expectedUpdate  entity.PatronUpdate
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+			request: &library.UpdatePatronRequest{
+				Id:         patron.ID,
+				Phone:      phone,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email", "phone"}},
+				Etag:       `"1"`,
+			},
+			expectedUpdate: entity.PatronUpdate{ID: patron.ID, Email: &email, Phone: &phone, ExpectedVersion: 1},
+		},
+		{
+			name: "Run with contact errors",
+			request: &library.UpdatePatronRequest{
+				Id:         patron.ID,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email", "phone"}},
+			},
+			expectedUpdate:  entity.PatronUpdate{ID: patron.ID, Email: &email, Phone: &email},
+			repositoryError: entity.ErrPatronContactRequired,
+			expectedError:   status.Error(codes.InvalidArgument, "patron must have an email or a phone"),
+		},
+		{
+			name: "Run with version mismatch errors",
+			request: &library.UpdatePatronRequest{
+				Id:         patron.ID,
+				Phone:      phone,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"phone"}},
+				Etag:       "2",
+			},
+			expectedUpdate:  entity.PatronUpdate{ID: patron.ID, Phone: &phone, ExpectedVersion: 2},
+			repositoryError: entity.ErrVersionMismatch,
+			expectedError:   status.Error(codes.FailedPrecondition, "version mismatch"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().UpdatePatron(ctx, tc.expectedUpdate).Return(patron, tc.repositoryError)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := expectPatronMessage(ctx, ctrl, repository.OutboxKindPatron.String()+"_"+patron.ID+"_", nil, times)
+
+			uc := getDefaultPatronUseCase(ctrl, patronRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+			resp, err := uc.UpdatePatron(ctx, tc.request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, patronToProto(patron), resp.GetPatron())
+		})
+	}
+}
+
+func TestUpdatePatronInvalidMask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	uc := getDefaultPatronUseCase(ctrl, mocks.NewMockPatronRepository(ctrl), mocks.NewMockTransactor(ctrl),
+		mocks.NewMockOutboxRepository(ctrl))
+	_, err := uc.UpdatePatron(context.Background(), &library.UpdatePatronRequest{
+		Id:         uuid.NewString(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"card_number"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetPatron(t *testing.T) {
+	t.Parallel()
+
+	patron := newTestPatron()
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrPatronNotFound,
+			expectedError:   status.Error(codes.NotFound, "patron not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().GetPatron(ctx, patron.ID).Return(patron, tc.repositoryError)
+
+			uc := getDefaultPatronUseCase(ctrl, patronRepo, mocks.NewMockTransactor(ctrl), mocks.NewMockOutboxRepository(ctrl))
+			resp, err := uc.GetPatron(ctx, &library.GetPatronRequest{Id: patron.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, patronToProto(patron), resp.GetPatron())
+			require.Equal(t, "1", resp.GetPatron().GetEtag())
+		})
+	}
+}
+
+func TestRenewMembership(t *testing.T) {
+	t.Parallel()
+
+	patron := newTestPatron()
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrPatronNotFound,
+			expectedError:   status.Error(codes.NotFound, "patron not found"),
+		},
+		{
+			name:          "Run with outbox errors",
+			outboxError:   errors.New("test"),
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().RenewMembership(ctx, patron.ID, 6).Return(patron, tc.repositoryError)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := expectPatronMessage(ctx, ctrl, repository.OutboxKindPatron.String()+"_"+patron.ID+"_",
+				tc.outboxError, times)
+
+			uc := getDefaultPatronUseCase(ctrl, patronRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+			resp, err := uc.RenewMembership(ctx, &library.RenewMembershipRequest{Id: patron.ID, Months: 6})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, patronToProto(patron), resp.GetPatron())
+		})
+	}
+}
+
+func TestBlockPatron(t *testing.T) {
+	t.Parallel()
+
+	patron := newTestPatron()
+	patron.Blocked = true
+	patron.BlockReason = "Lost items"
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrPatronNotFound,
+			expectedError:   status.Error(codes.NotFound, "patron not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			patronRepo := mocks.NewMockPatronRepository(ctrl)
+			patronRepo.EXPECT().BlockPatron(ctx, patron.ID, true, patron.BlockReason).Return(patron, tc.repositoryError)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := expectPatronMessage(ctx, ctrl, repository.OutboxKindPatron.String()+"_"+patron.ID+"_", nil, times)
+
+			uc := getDefaultPatronUseCase(ctrl, patronRepo, newPassingTransactor(ctx, ctrl), outboxRepo)
+			resp, err := uc.BlockPatron(ctx, &library.BlockPatronRequest{
+				Id:      patron.ID,
+				Blocked: true,
+				Reason:  patron.BlockReason,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.GetPatron().GetBlocked())
+			require.Equal(t, patron.BlockReason, resp.GetPatron().GetBlockReason())
+		})
+	}
+}
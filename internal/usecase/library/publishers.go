@@ -0,0 +1,94 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) RegisterPublisher(ctx context.Context, request *library.RegisterPublisherRequest) (*library.RegisterPublisherResponse, error) {
+	var publisher entity.Publisher
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Register publisher request is being made to the database.")
+
+		var txErr error
+		publisher, txErr = l.publisherRepository.RegisterPublisher(ctx, entity.Publisher{
+			Name:    request.GetName(),
+			Country: request.GetCountry(),
+			Website: request.GetWebsite(),
+		})
+
+		if txErr != nil {
+			return txErr
+		}
+
+		serialized, txErr := json.Marshal(publisher)
+
+		if txErr != nil {
+			return txErr
+		}
+
+		idempotencyKey := repository.OutboxKindPublisher.String() + "_" + publisher.ID
+		return l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindPublisher, serialized)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RegisterPublisherResponse{
+		Publisher: publisherToProto(publisher),
+	}, nil
+}
+
+func (l *libraryImpl) GetPublisherInfo(ctx context.Context, request *library.GetPublisherInfoRequest) (*library.GetPublisherInfoResponse, error) {
+	l.logger.Info("Get publisher info request is being made to the database.")
+	publisher, err := l.publisherRepository.GetPublisherInfo(ctx, request.GetId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.GetPublisherInfoResponse{
+		Publisher: publisherToProto(publisher),
+	}, nil
+}
+
+func (l *libraryImpl) ListPublisherBooks(ctx context.Context, request *library.ListPublisherBooksRequest) (*library.ListPublisherBooksResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	params := entity.ListBooksParams{
+		Filter: entity.BookFilter{
+			PublisherID: request.GetPublisherId(),
+		},
+		OrderBy:    bookOrderFromProto(request.GetOrderBy()),
+		Descending: request.GetDescending(),
+		Limit:      pageSize + 1,
+	}
+
+	if request.GetPageToken() != "" {
+		if err := setBookPageToken(request.GetPageToken(), &params); err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	l.logger.Info("List publisher books request is being made to the database.")
+	books, err := l.publisherRepository.ListPublisherBooks(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListPublisherBooksResponse{}
+	response.Books, response.NextPageToken, err = getBooksPage(params, pageSize, books)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return response, nil
+}
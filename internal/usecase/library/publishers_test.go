@@ -0,0 +1,237 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultPublisherUseCaseWithOutbox(
+	ctrl *gomock.Controller,
+	publisherRepository *mocks.MockPublisherRepository,
+	transactor *mocks.MockTransactor,
+	outboxRepository *mocks.MockOutboxRepository,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, outboxRepository, mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), publisherRepository,
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		mocks.NewMockCopyRepository(ctrl), mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func TestRegisterPublisher(t *testing.T) {
+	t.Parallel()
+
+	request := &library.RegisterPublisherRequest{
+		Name:    "Test",
+		Country: "GB",
+		Website: "https://example.com",
+	}
+
+	publisher := entity.Publisher{
+		ID:      uuid.NewString(),
+		Name:    request.GetName(),
+		Country: request.GetCountry(),
+		Website: request.GetWebsite(),
+		Version: 1,
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		outboxError     error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+		{
+			name:          "Run with outbox errors",
+			outboxError:   errors.New("test"),
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			publisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			publisherRepo.EXPECT().RegisterPublisher(ctx, entity.Publisher{
+				Name:    request.GetName(),
+				Country: request.GetCountry(),
+				Website: request.GetWebsite(),
+			}).Return(publisher, tc.repositoryError)
+
+			transactor := mocks.NewMockTransactor(ctrl)
+			transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				},
+			)
+
+			times := 0
+			if tc.repositoryError == nil {
+				times = 1
+			}
+			outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			outboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindPublisher.String()+"_"+publisher.ID,
+				repository.OutboxKindPublisher, gomock.Any()).Return(tc.outboxError).Times(times)
+
+			uc := getDefaultPublisherUseCaseWithOutbox(ctrl, publisherRepo, transactor, outboxRepo)
+			resp, err := uc.RegisterPublisher(ctx, request)
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, publisherToProto(publisher), resp.GetPublisher())
+		})
+	}
+}
+
+func TestGetPublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	publisher := entity.Publisher{
+		ID:        uuid.NewString(),
+		Name:      "Test",
+		Country:   "GB",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Version:   3,
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrPublisherNotFound,
+			expectedError:   status.Error(codes.NotFound, "publisher not found"),
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			publisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			publisherRepo.EXPECT().GetPublisherInfo(ctx, publisher.ID).Return(publisher, tc.repositoryError)
+
+			uc := getDefaultPublisherUseCaseWithOutbox(ctrl, publisherRepo, nil, nil)
+			resp, err := uc.GetPublisherInfo(ctx, &library.GetPublisherInfoRequest{Id: publisher.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "3", resp.GetPublisher().GetEtag())
+			require.Equal(t, publisherToProto(publisher), resp.GetPublisher())
+		})
+	}
+}
+
+func TestListPublisherBooks(t *testing.T) {
+	t.Parallel()
+
+	publisherID := uuid.NewString()
+	books := []entity.Book{
+		{ID: uuid.NewString(), Name: "A", PublisherID: publisherID},
+		{ID: uuid.NewString(), Name: "B", PublisherID: publisherID},
+		{ID: uuid.NewString(), Name: "C", PublisherID: publisherID},
+	}
+
+	testCases := []struct {
+		name            string
+		pageSize        int32
+		books           []entity.Book
+		repositoryError error
+		expectedBooks   int
+		expectedToken   bool
+		expectedError   error
+	}{
+		{
+			name:          "Run with next page",
+			pageSize:      2,
+			books:         books,
+			expectedBooks: 2,
+			expectedToken: true,
+		},
+		{
+			name:          "Run with last page",
+			pageSize:      3,
+			books:         books,
+			expectedBooks: 3,
+		},
+		{
+			name:            "Run with not found errors",
+			pageSize:        2,
+			repositoryError: entity.ErrPublisherNotFound,
+			expectedError:   status.Error(codes.NotFound, "publisher not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			publisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			publisherRepo.EXPECT().ListPublisherBooks(ctx, entity.ListBooksParams{
+				Filter: entity.BookFilter{PublisherID: publisherID},
+				Limit:  int(tc.pageSize) + 1,
+			}).Return(tc.books, tc.repositoryError)
+
+			uc := getDefaultPublisherUseCaseWithOutbox(ctrl, publisherRepo, nil, nil)
+			resp, err := uc.ListPublisherBooks(ctx, &library.ListPublisherBooksRequest{
+				PublisherId: publisherID,
+				PageSize:    tc.pageSize,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetBooks(), tc.expectedBooks)
+			require.Equal(t, tc.expectedToken, resp.GetNextPageToken() != "")
+			require.Equal(t, publisherID, resp.GetBooks()[0].GetPublisherId())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) SubmitReview(ctx context.Context, request *library.SubmitReviewRequest) (*library.SubmitReviewResponse, error) {
+	l.logger.Info("Submit review request is being made to the database.")
+	review, err := l.reviewRepository.CreateReview(ctx, entity.Review{
+		BookID:   request.GetBookId(),
+		PatronID: request.GetPatronId(),
+		Rating:   int(request.GetRating()),
+		Text:     request.GetText(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.SubmitReviewResponse{
+		Review: reviewToProto(review),
+	}, nil
+}
+
+func (l *libraryImpl) ListBookReviews(ctx context.Context, request *library.ListBookReviewsRequest) (*library.ListBookReviewsResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	params := entity.ListReviewsParams{
+		BookID: request.GetBookId(),
+		Status: entity.ReviewStatusApproved,
+		Limit:  pageSize + 1,
+	}
+
+	if request.GetStatus() != library.ReviewStatus_REVIEW_STATUS_UNSPECIFIED {
+		params.Status = entity.ReviewStatus(request.GetStatus())
+	}
+
+	// Page tokens are bound to the book and the status of the reviews.
+	query := entity.ListReviewsParams{BookID: params.BookID, Status: params.Status}
+
+	if request.GetPageToken() != "" {
+		var token reviewPageToken
+
+		if err := decodePageToken(request.GetPageToken(), query, &token); err != nil {
+			return nil, l.convertErr(err)
+		}
+
+		params.After = &entity.ReviewCursor{
+			ID:        token.ID,
+			CreatedAt: token.CreatedAt,
+		}
+	}
+
+	l.logger.Info("List book reviews request is being made to the database.")
+	reviews, err := l.reviewRepository.ListReviews(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.ListBookReviewsResponse{}
+
+	if len(reviews) > pageSize {
+		reviews = reviews[:pageSize]
+		last := reviews[pageSize-1]
+
+		response.NextPageToken, err = encodePageToken(query, reviewPageToken{
+			ID:        last.ID,
+			CreatedAt: last.CreatedAt,
+		})
+
+		if err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	response.Reviews = make([]*library.Review, 0, len(reviews))
+	for _, review := range reviews {
+		response.Reviews = append(response.Reviews, reviewToProto(review))
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) ModerateReview(ctx context.Context, request *library.ModerateReviewRequest) (*library.ModerateReviewResponse, error) {
+	var review entity.Review
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Moderate review request is being made to the database.")
+
+		current, txErr := l.reviewRepository.LockReview(ctx, request.GetId())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		review, txErr = l.reviewRepository.ModerateReview(ctx, current.ID, entity.ReviewStatus(request.GetStatus()),
+			request.GetNote())
+
+		if txErr != nil {
+			return txErr
+		}
+
+		// The rating of the book only changes when the review enters or leaves the approved status.
+		var (
+			ratingDelta int64
+			countDelta  int
+		)
+
+		if current.Status == entity.ReviewStatusApproved {
+			ratingDelta -= int64(current.Rating)
+			countDelta--
+		}
+
+		if review.Status == entity.ReviewStatusApproved {
+			ratingDelta += int64(review.Rating)
+			countDelta++
+		}
+
+		if countDelta == 0 {
+			return nil
+		}
+
+		return l.reviewRepository.AdjustBookRating(ctx, review.BookID, ratingDelta, countDelta)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.ModerateReviewResponse{
+		Review: reviewToProto(review),
+	}, nil
+}
@@ -0,0 +1,298 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultReviewUseCase(
+	ctrl *gomock.Controller,
+	reviewRepository *mocks.MockReviewRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockAuthorRepository(ctrl),
+		mocks.NewMockBooksRepository(ctrl), mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), mocks.NewMockSeriesRepository(ctrl), mocks.NewMockWorkRepository(ctrl),
+		mocks.NewMockCopyRepository(ctrl), mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl), mocks.NewMockBranchRepository(ctrl),
+		mocks.NewMockTransferRepository(ctrl), reviewRepository)
+}
+
+func TestSubmitReview(t *testing.T) {
+	t.Parallel()
+
+	review := entity.Review{
+		ID:       uuid.NewString(),
+		BookID:   uuid.NewString(),
+		PatronID: uuid.NewString(),
+		Rating:   4,
+		Text:     "Good",
+		Status:   entity.ReviewStatusPending,
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with duplicate review errors",
+			repositoryError: entity.ErrReviewExists,
+			expectedError:   status.Error(codes.AlreadyExists, "patron has already reviewed this book"),
+		},
+		{
+			name:            "Run with book not found errors",
+			repositoryError: entity.ErrBookNotFound,
+			expectedError:   status.Error(codes.NotFound, "book not found"),
+		},
+		{
+			name:            "Run with patron not found errors",
+			repositoryError: entity.ErrPatronNotFound,
+			expectedError:   status.Error(codes.NotFound, "patron not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().CreateReview(ctx, entity.Review{
+				BookID:   review.BookID,
+				PatronID: review.PatronID,
+				Rating:   review.Rating,
+				Text:     review.Text,
+			}).Return(review, tc.repositoryError)
+
+			uc := getDefaultReviewUseCase(ctrl, reviewRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.SubmitReview(ctx, &library.SubmitReviewRequest{
+				BookId:   review.BookID,
+				PatronId: review.PatronID,
+				Rating:   int32(review.Rating),
+				Text:     review.Text,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, reviewToProto(review), resp.GetReview())
+			require.Equal(t, library.ReviewStatus_REVIEW_STATUS_PENDING, resp.GetReview().GetStatus())
+		})
+	}
+}
+
+func TestListBookReviews(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.NewString()
+	createdAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	reviews := []entity.Review{
+		{ID: uuid.NewString(), BookID: bookID, Rating: 5, Status: entity.ReviewStatusApproved, CreatedAt: createdAt},
+		{ID: uuid.NewString(), BookID: bookID, Rating: 3, Status: entity.ReviewStatusApproved,
+			CreatedAt: createdAt.Add(-time.Hour)},
+		{ID: uuid.NewString(), BookID: bookID, Rating: 4, Status: entity.ReviewStatusApproved,
+			CreatedAt: createdAt.Add(-2 * time.Hour)},
+	}
+
+	validToken, err := encodePageToken(entity.ListReviewsParams{BookID: bookID, Status: entity.ReviewStatusApproved},
+		reviewPageToken{ID: reviews[0].ID, CreatedAt: reviews[0].CreatedAt})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		request           *library.ListBookReviewsRequest
+		repositoryReviews []entity.Review
+		repositoryError   error
+		expectedStatus    entity.ReviewStatus
+		expectedLimit     int
+		expectedAfter     *entity.ReviewCursor
+		expectedReviews   int
+		expectedNextToken bool
+		expectedError     error
+	}{
+		{
+			name:              "Run with next page",
+			request:           &library.ListBookReviewsRequest{BookId: bookID, PageSize: 2},
+			repositoryReviews: reviews,
+			expectedStatus:    entity.ReviewStatusApproved,
+			expectedLimit:     3,
+			expectedReviews:   2,
+			expectedNextToken: true,
+		},
+		{
+			name:              "Run with last page",
+			request:           &library.ListBookReviewsRequest{BookId: bookID, PageSize: 2, PageToken: validToken},
+			repositoryReviews: reviews[1:],
+			expectedStatus:    entity.ReviewStatusApproved,
+			expectedLimit:     3,
+			expectedAfter:     &entity.ReviewCursor{ID: reviews[0].ID, CreatedAt: reviews[0].CreatedAt},
+			expectedReviews:   2,
+		},
+		{
+			name: "Run with pending reviews",
+			request: &library.ListBookReviewsRequest{
+				BookId: bookID,
+				Status: library.ReviewStatus_REVIEW_STATUS_PENDING,
+			},
+			expectedStatus: entity.ReviewStatusPending,
+			expectedLimit:  defaultPageSize + 1,
+		},
+		{
+			name:          "Run with malformed page token",
+			request:       &library.ListBookReviewsRequest{BookId: bookID, PageToken: "???"},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name: "Run with page token of another status",
+			request: &library.ListBookReviewsRequest{
+				BookId:    bookID,
+				Status:    library.ReviewStatus_REVIEW_STATUS_PENDING,
+				PageToken: validToken,
+			},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:            "Run with internal errors",
+			request:         &library.ListBookReviewsRequest{BookId: bookID},
+			repositoryError: errors.New("test error"),
+			expectedStatus:  entity.ReviewStatusApproved,
+			expectedLimit:   defaultPageSize + 1,
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().ListReviews(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, params entity.ListReviewsParams) ([]entity.Review, error) {
+					require.Equal(t, bookID, params.BookID)
+					require.Equal(t, tc.expectedStatus, params.Status)
+					require.Equal(t, tc.expectedLimit, params.Limit)
+					require.Equal(t, tc.expectedAfter, params.After)
+					return tc.repositoryReviews, tc.repositoryError
+				},
+			).MaxTimes(1)
+
+			uc := getDefaultReviewUseCase(ctrl, reviewRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.ListBookReviews(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Len(t, resp.GetReviews(), tc.expectedReviews)
+				require.Equal(t, tc.expectedNextToken, resp.GetNextPageToken() != "")
+			}
+		})
+	}
+}
+
+func TestModerateReview(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.NewString()
+	reviewID := uuid.NewString()
+
+	testCases := []struct {
+		name          string
+		current       entity.ReviewStatus
+		target        entity.ReviewStatus
+		lockError     error
+		ratingDelta   int64
+		countDelta    int
+		expectedError error
+	}{
+		{
+			name:        "Run with approval of pending review",
+			current:     entity.ReviewStatusPending,
+			target:      entity.ReviewStatusApproved,
+			ratingDelta: 4,
+			countDelta:  1,
+		},
+		{
+			name:        "Run with rejection of approved review",
+			current:     entity.ReviewStatusApproved,
+			target:      entity.ReviewStatusRejected,
+			ratingDelta: -4,
+			countDelta:  -1,
+		},
+		{
+			name:    "Run with rejection of pending review",
+			current: entity.ReviewStatusPending,
+			target:  entity.ReviewStatusRejected,
+		},
+		{
+			name:    "Run with approval of approved review",
+			current: entity.ReviewStatusApproved,
+			target:  entity.ReviewStatusApproved,
+		},
+		{
+			name:          "Run with not found errors",
+			lockError:     entity.ErrReviewNotFound,
+			expectedError: status.Error(codes.NotFound, "review not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			reviewRepo := mocks.NewMockReviewRepository(ctrl)
+			reviewRepo.EXPECT().LockReview(ctx, reviewID).
+				Return(entity.Review{ID: reviewID, BookID: bookID, Rating: 4, Status: tc.current}, tc.lockError)
+
+			if tc.lockError == nil {
+				reviewRepo.EXPECT().ModerateReview(ctx, reviewID, tc.target, "ok").
+					Return(entity.Review{ID: reviewID, BookID: bookID, Rating: 4, Status: tc.target, ModerationNote: "ok"}, nil)
+			}
+
+			if tc.countDelta != 0 {
+				reviewRepo.EXPECT().AdjustBookRating(ctx, bookID, tc.ratingDelta, tc.countDelta).Return(nil)
+			}
+
+			uc := getDefaultReviewUseCase(ctrl, reviewRepo, newPassingTransactor(ctx, ctrl))
+			resp, err := uc.ModerateReview(ctx, &library.ModerateReviewRequest{
+				Id:     reviewID,
+				Status: library.ReviewStatus(tc.target),
+				Note:   "ok",
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				require.Equal(t, status.Convert(tc.expectedError).Message(), status.Convert(err).Message())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, library.ReviewStatus(tc.target), resp.GetReview().GetStatus())
+			require.Equal(t, "ok", resp.GetReview().GetModerationNote())
+		})
+	}
+}
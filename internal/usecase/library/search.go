@@ -0,0 +1,81 @@
+package library
+
+import (
+	"context"
+	"strings"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) SearchCatalog(ctx context.Context, request *library.SearchCatalogRequest) (*library.SearchCatalogResponse, error) {
+	pageSize := getPageSize(request.GetPageSize())
+
+	// Books are matched by the primary subtag, as their search configuration is chosen by it.
+	language, _, _ := strings.Cut(strings.ToLower(request.GetLanguage()), "-")
+
+	params := entity.SearchParams{
+		Query:    request.GetQuery(),
+		Language: language,
+		Limit:    pageSize + 1,
+	}
+
+	// Cursors only make sense for the same query in the same language.
+	query := entity.SearchParams{Query: params.Query, Language: params.Language}
+
+	if request.GetPageToken() != "" {
+		var token searchPageToken
+
+		if err := decodePageToken(request.GetPageToken(), query, &token); err != nil {
+			return nil, l.convertErr(err)
+		}
+
+		params.After = &entity.SearchCursor{
+			Score: token.Score,
+			Kind:  token.Kind,
+			ID:    token.ID,
+		}
+	}
+
+	l.logger.Info("Search catalog request is being made to the database.")
+	results, err := l.booksRepository.SearchCatalog(ctx, params)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.SearchCatalogResponse{}
+
+	if len(results) > pageSize {
+		results = results[:pageSize]
+		last := results[pageSize-1]
+
+		response.NextPageToken, err = encodePageToken(query, searchPageToken{
+			Score: last.Score,
+			Kind:  last.Kind,
+			ID:    last.ID,
+		})
+
+		if err != nil {
+			return nil, l.convertErr(err)
+		}
+	}
+
+	response.Results = make([]*library.SearchCatalogResult, 0, len(results))
+	for _, result := range results {
+		kind := library.SearchResultKind_SEARCH_RESULT_KIND_BOOK
+		if result.Kind == entity.SearchResultKindAuthor {
+			kind = library.SearchResultKind_SEARCH_RESULT_KIND_AUTHOR
+		}
+
+		response.Results = append(response.Results, &library.SearchCatalogResult{
+			Kind:    kind,
+			Id:      result.ID,
+			Name:    result.Name,
+			Snippet: result.Snippet,
+			Score:   result.Score,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,120 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchCatalog(t *testing.T) {
+	t.Parallel()
+
+	results := []entity.SearchResult{
+		{Kind: entity.SearchResultKindBook, ID: uuid.NewString(), Name: "War and Peace", Snippet: "<b>War</b> and Peace", Score: 0.6},
+		{Kind: entity.SearchResultKindAuthor, ID: uuid.NewString(), Name: "Warren", Snippet: "<b>Warren</b>", Score: 0.3},
+	}
+
+	cursor := entity.SearchCursor{Score: results[0].Score, Kind: results[0].Kind, ID: results[0].ID}
+	secondPage, err := encodePageToken(entity.SearchParams{Query: "war"}, searchPageToken(cursor))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		request           *library.SearchCatalogRequest
+		repositoryResults []entity.SearchResult
+		repositoryError   error
+		expectedParams    entity.SearchParams
+		expectedResults   []*library.SearchCatalogResult
+		expectedNextToken bool
+		expectedError     error
+	}{
+		{
+			name:              "Run with next page",
+			request:           &library.SearchCatalogRequest{Query: "war", PageSize: 1, Language: "en-GB"},
+			repositoryResults: results,
+			expectedParams:    entity.SearchParams{Query: "war", Language: "en", Limit: 2},
+			expectedResults: []*library.SearchCatalogResult{
+				{
+					Kind:    library.SearchResultKind_SEARCH_RESULT_KIND_BOOK,
+					Id:      results[0].ID,
+					Name:    results[0].Name,
+					Snippet: results[0].Snippet,
+					Score:   results[0].Score,
+				},
+			},
+			expectedNextToken: true,
+		},
+		{
+			name:              "Run with second page",
+			request:           &library.SearchCatalogRequest{Query: "war", PageSize: 1, PageToken: secondPage},
+			repositoryResults: results[1:],
+			expectedParams:    entity.SearchParams{Query: "war", Limit: 2, After: &cursor},
+			expectedResults: []*library.SearchCatalogResult{
+				{
+					Kind:    library.SearchResultKind_SEARCH_RESULT_KIND_AUTHOR,
+					Id:      results[1].ID,
+					Name:    results[1].Name,
+					Snippet: results[1].Snippet,
+					Score:   results[1].Score,
+				},
+			},
+		},
+		{
+			name:          "Run with page token of another language",
+			request:       &library.SearchCatalogRequest{Query: "war", Language: "en", PageToken: secondPage},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:          "Run with page token of another query",
+			request:       &library.SearchCatalogRequest{Query: "peace", PageToken: secondPage},
+			expectedError: status.Error(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:            "Run with internal errors",
+			request:         &library.SearchCatalogRequest{Query: "война", Language: "RU"},
+			repositoryError: errors.New("test error"),
+			expectedParams:  entity.SearchParams{Query: "война", Language: "ru", Limit: defaultPageSize + 1},
+			expectedError:   status.Error(codes.Internal, "test error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().SearchCatalog(ctx, tc.expectedParams).
+				Return(tc.repositoryResults, tc.repositoryError).MaxTimes(1)
+
+			uc := getDefaultBookUseCase(ctrl, bookRepo)
+			resp, err := uc.SearchCatalog(ctx, tc.request)
+			s, ok := status.FromError(err)
+			expS, expOk := status.FromError(tc.expectedError)
+			require.Equal(t, expOk, ok)
+			if ok {
+				require.Equal(t, expS.Code(), s.Code())
+			} else {
+				require.Equal(t, tc.expectedResults, resp.GetResults())
+				require.Equal(t, tc.expectedNextToken, resp.GetNextPageToken() != "")
+
+				if tc.expectedNextToken {
+					var token searchPageToken
+					query := entity.SearchParams{Query: tc.expectedParams.Query, Language: tc.expectedParams.Language}
+					require.NoError(t, decodePageToken(resp.GetNextPageToken(), query, &token))
+					require.Equal(t, cursor, entity.SearchCursor(token))
+				}
+			}
+		})
+	}
+}
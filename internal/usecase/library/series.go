@@ -0,0 +1,147 @@
+package library
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/samber/lo"
+)
+
+func (l *libraryImpl) CreateSeries(ctx context.Context, request *library.CreateSeriesRequest) (*library.CreateSeriesResponse, error) {
+	l.logger.Info("Create series request is being made to the database.")
+	series, err := l.seriesRepository.CreateSeries(ctx, entity.Series{
+		Name:        request.GetName(),
+		Description: request.GetDescription(),
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.CreateSeriesResponse{
+		Series: seriesToProto(series),
+	}, nil
+}
+
+func (l *libraryImpl) GetSeries(ctx context.Context, request *library.GetSeriesRequest) (*library.GetSeriesResponse, error) {
+	l.logger.Info("Get series request is being made to the database.")
+	series, err := l.seriesRepository.GetSeries(ctx, request.GetId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	ids := lo.Map(series.Volumes, func(volume entity.SeriesVolume, _ int) string {
+		return volume.BookID
+	})
+
+	books, err := l.booksRepository.GetBooksInfo(ctx, ids)
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	found := lo.KeyBy(books, func(book entity.Book) string {
+		return book.ID
+	})
+
+	response := &library.GetSeriesResponse{
+		Series:  seriesToProto(series),
+		Volumes: make([]*library.SeriesVolume, 0, len(series.Volumes)),
+	}
+
+	for _, volume := range series.Volumes {
+		if book, ok := found[volume.BookID]; ok {
+			response.Volumes = append(response.Volumes, &library.SeriesVolume{
+				Volume: volume.Volume,
+				Book:   bookToProto(book),
+			})
+		}
+	}
+
+	return response, nil
+}
+
+func (l *libraryImpl) SetBookSeries(ctx context.Context, request *library.SetBookSeriesRequest) (*library.SetBookSeriesResponse, error) {
+	var result entity.SeriesVolume
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Set book series request is being made to the database.")
+
+		seriesIDs := []string{request.GetSeriesId()}
+
+		current, txErr := l.seriesRepository.GetBookSeries(ctx, request.GetBookId())
+
+		switch {
+		case errors.Is(txErr, entity.ErrBookNotInSeries):
+		case txErr != nil:
+			return txErr
+		case current.SeriesID != request.GetSeriesId():
+			seriesIDs = append(seriesIDs, current.SeriesID)
+		}
+
+		if txErr = l.seriesRepository.LockSeries(ctx, seriesIDs); txErr != nil {
+			return txErr
+		}
+
+		txErr = l.seriesRepository.SetBookSeries(ctx, entity.SeriesVolume{
+			SeriesID: request.GetSeriesId(),
+			BookID:   request.GetBookId(),
+			Volume:   request.GetVolume(),
+		})
+
+		if txErr != nil {
+			return txErr
+		}
+
+		result, txErr = l.seriesRepository.GetBookSeries(ctx, request.GetBookId())
+
+		return txErr
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.SetBookSeriesResponse{
+		Series: bookSeriesToProto(result),
+	}, nil
+}
+
+func (l *libraryImpl) RemoveBookFromSeries(ctx context.Context, request *library.RemoveBookFromSeriesRequest) (*library.RemoveBookFromSeriesResponse, error) {
+	l.logger.Info("Remove book from series request is being made to the database.")
+
+	if err := l.seriesRepository.RemoveBookFromSeries(ctx, request.GetBookId()); err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.RemoveBookFromSeriesResponse{}, nil
+}
+
+func (l *libraryImpl) ReorderSeries(ctx context.Context, request *library.ReorderSeriesRequest) (*library.ReorderSeriesResponse, error) {
+	volumes := lo.Map(request.GetVolumes(), func(volume *library.ReorderSeriesRequest_Volume, _ int) entity.SeriesVolume {
+		return entity.SeriesVolume{
+			SeriesID: request.GetSeriesId(),
+			BookID:   volume.GetBookId(),
+			Volume:   volume.GetVolume(),
+		}
+	})
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		l.logger.Info("Reorder series request is being made to the database.")
+
+		if txErr := l.seriesRepository.LockSeries(ctx, []string{request.GetSeriesId()}); txErr != nil {
+			return txErr
+		}
+
+		return l.seriesRepository.SetSeriesVolumes(ctx, request.GetSeriesId(), volumes)
+	})
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	return &library.ReorderSeriesResponse{}, nil
+}
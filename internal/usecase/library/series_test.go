@@ -0,0 +1,331 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getDefaultSeriesUseCase(
+	ctrl *gomock.Controller,
+	seriesRepository *mocks.MockSeriesRepository,
+	booksRepository *mocks.MockBooksRepository,
+	transactor *mocks.MockTransactor,
+) *libraryImpl {
+	return New(zap.NewNop(), transactor, mocks.NewMockOutboxRepository(ctrl), mocks.NewMockAuthorRepository(ctrl),
+		booksRepository, mocks.NewMockChangeLogRepository(ctrl), mocks.NewMockPublisherRepository(ctrl),
+		mocks.NewMockGenreRepository(ctrl), seriesRepository, mocks.NewMockWorkRepository(ctrl),
+		mocks.NewMockCopyRepository(ctrl), mocks.NewMockPatronRepository(ctrl), mocks.NewMockLoanRepository(ctrl),
+		mocks.NewMockHoldRepository(ctrl), mocks.NewMockFineRepository(ctrl),
+		mocks.NewMockBranchRepository(ctrl), mocks.NewMockTransferRepository(ctrl), mocks.NewMockReviewRepository(ctrl))
+}
+
+func newPassingTransactor(ctx context.Context, ctrl *gomock.Controller) *mocks.MockTransactor {
+	transactor := mocks.NewMockTransactor(ctrl)
+	transactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		},
+	)
+
+	return transactor
+}
+
+func TestCreateSeries(t *testing.T) {
+	t.Parallel()
+
+	series := entity.Series{ID: uuid.NewString(), Name: "Discworld", Description: "Comic fantasy"}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with internal errors",
+			repositoryError: errors.New("test"),
+			expectedError:   status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			seriesRepo.EXPECT().CreateSeries(ctx, entity.Series{Name: series.Name, Description: series.Description}).
+				Return(series, tc.repositoryError)
+
+			uc := getDefaultSeriesUseCase(ctrl, seriesRepo, mocks.NewMockBooksRepository(ctrl), mocks.NewMockTransactor(ctrl))
+			resp, err := uc.CreateSeries(ctx, &library.CreateSeriesRequest{Name: series.Name, Description: series.Description})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, seriesToProto(series), resp.GetSeries())
+		})
+	}
+}
+
+func TestGetSeries(t *testing.T) {
+	t.Parallel()
+
+	first, between, second := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	series := entity.Series{
+		ID:   uuid.NewString(),
+		Name: "Discworld",
+		Volumes: []entity.SeriesVolume{
+			{BookID: first, Volume: 1},
+			{BookID: between, Volume: 1.5},
+			{BookID: second, Volume: 2},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		seriesError     error
+		booksError      error
+		expectedVolumes []float64
+		expectedError   error
+	}{
+		{
+			name:            "Run without errors",
+			expectedVolumes: []float64{1, 2},
+		},
+		{
+			name:          "Run with not found errors",
+			seriesError:   entity.ErrSeriesNotFound,
+			expectedError: status.Error(codes.NotFound, "series not found"),
+		},
+		{
+			name:          "Run with books errors",
+			booksError:    errors.New("test"),
+			expectedError: status.Error(codes.Internal, "test"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			seriesRepo.EXPECT().GetSeries(ctx, series.ID).Return(series, tc.seriesError)
+
+			// The book of volume 1.5 has been deleted meanwhile, the repository returns books in its own order.
+			bookRepo := mocks.NewMockBooksRepository(ctrl)
+			bookRepo.EXPECT().GetBooksInfo(ctx, []string{first, between, second}).
+				Return([]entity.Book{{ID: second, Name: "Second"}, {ID: first, Name: "First"}}, tc.booksError).
+				Times(lo.Ternary(tc.seriesError == nil, 1, 0))
+
+			uc := getDefaultSeriesUseCase(ctrl, seriesRepo, bookRepo, mocks.NewMockTransactor(ctrl))
+			resp, err := uc.GetSeries(ctx, &library.GetSeriesRequest{Id: series.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, series.Name, resp.GetSeries().GetName())
+			require.Len(t, resp.GetVolumes(), len(tc.expectedVolumes))
+			for i, volume := range resp.GetVolumes() {
+				require.InDelta(t, tc.expectedVolumes[i], volume.GetVolume(), 0)
+			}
+			require.Equal(t, first, resp.GetVolumes()[0].GetBook().GetId())
+		})
+	}
+}
+
+func TestSetBookSeries(t *testing.T) {
+	t.Parallel()
+
+	bookID, seriesID, previousID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	volume := entity.SeriesVolume{SeriesID: seriesID, BookID: bookID, Volume: 2.5}
+
+	testCases := []struct {
+		name          string
+		current       entity.SeriesVolume
+		currentError  error
+		lockedIDs     []string
+		lockError     error
+		setError      error
+		expectedError error
+	}{
+		{
+			name:         "Run with book without series",
+			currentError: entity.ErrBookNotInSeries,
+			lockedIDs:    []string{seriesID},
+		},
+		{
+			name:      "Run with book in the same series",
+			current:   entity.SeriesVolume{SeriesID: seriesID, BookID: bookID, Volume: 3},
+			lockedIDs: []string{seriesID},
+		},
+		{
+			name:      "Run with move from another series",
+			current:   entity.SeriesVolume{SeriesID: previousID, BookID: bookID, Volume: 1},
+			lockedIDs: []string{seriesID, previousID},
+		},
+		{
+			name:          "Run with unknown series",
+			currentError:  entity.ErrBookNotInSeries,
+			lockedIDs:     []string{seriesID},
+			lockError:     entity.ErrSeriesNotFound,
+			expectedError: status.Error(codes.NotFound, "series not found"),
+		},
+		{
+			name:          "Run with taken volume",
+			currentError:  entity.ErrBookNotInSeries,
+			lockedIDs:     []string{seriesID},
+			setError:      entity.ErrSeriesVolumeTaken,
+			expectedError: status.Error(codes.AlreadyExists, "volume is already taken in the series"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			result := entity.SeriesVolume{SeriesID: seriesID, SeriesName: "Discworld", BookID: bookID, Volume: 2.5}
+
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			gomock.InOrder(
+				seriesRepo.EXPECT().GetBookSeries(ctx, bookID).Return(tc.current, tc.currentError),
+				seriesRepo.EXPECT().LockSeries(ctx, tc.lockedIDs).Return(tc.lockError),
+				seriesRepo.EXPECT().SetBookSeries(ctx, volume).Return(tc.setError).
+					Times(lo.Ternary(tc.lockError == nil, 1, 0)),
+				seriesRepo.EXPECT().GetBookSeries(ctx, bookID).Return(result, nil).
+					Times(lo.Ternary(tc.expectedError == nil, 1, 0)),
+			)
+
+			uc := getDefaultSeriesUseCase(ctrl, seriesRepo, mocks.NewMockBooksRepository(ctrl), newPassingTransactor(ctx, ctrl))
+			resp, err := uc.SetBookSeries(ctx, &library.SetBookSeriesRequest{
+				BookId:   bookID,
+				SeriesId: seriesID,
+				Volume:   volume.Volume,
+			})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, bookSeriesToProto(result), resp.GetSeries())
+		})
+	}
+}
+
+func TestRemoveBookFromSeries(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedCode    codes.Code
+	}{
+		{
+			name:         "Run without errors",
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "Run with book without series",
+			repositoryError: entity.ErrBookNotInSeries,
+			expectedCode:    codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			bookID := uuid.NewString()
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			seriesRepo.EXPECT().RemoveBookFromSeries(ctx, bookID).Return(tc.repositoryError)
+
+			uc := getDefaultSeriesUseCase(ctrl, seriesRepo, mocks.NewMockBooksRepository(ctrl), mocks.NewMockTransactor(ctrl))
+			_, err := uc.RemoveBookFromSeries(ctx, &library.RemoveBookFromSeriesRequest{BookId: bookID})
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestReorderSeries(t *testing.T) {
+	t.Parallel()
+
+	seriesID, first, second := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	request := &library.ReorderSeriesRequest{
+		SeriesId: seriesID,
+		Volumes: []*library.ReorderSeriesRequest_Volume{
+			{BookId: first, Volume: 2},
+			{BookId: second, Volume: 1},
+		},
+	}
+	volumes := []entity.SeriesVolume{
+		{SeriesID: seriesID, BookID: first, Volume: 2},
+		{SeriesID: seriesID, BookID: second, Volume: 1},
+	}
+
+	testCases := []struct {
+		name         string
+		lockError    error
+		setError     error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Run without errors",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Run with unknown series",
+			lockError:    entity.ErrSeriesNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "Run with book from another series",
+			setError:     entity.ErrBookNotInSeries,
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			gomock.InOrder(
+				seriesRepo.EXPECT().LockSeries(ctx, []string{seriesID}).Return(tc.lockError),
+				seriesRepo.EXPECT().SetSeriesVolumes(ctx, seriesID, volumes).Return(tc.setError).
+					Times(lo.Ternary(tc.lockError == nil, 1, 0)),
+			)
+
+			uc := getDefaultSeriesUseCase(ctrl, seriesRepo, mocks.NewMockBooksRepository(ctrl), newPassingTransactor(ctx, ctrl))
+			_, err := uc.ReorderSeries(ctx, request)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -1,20 +1,519 @@
 package library
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (l *libraryImpl) convertErr(err error) error {
 	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
 	case errors.Is(err, entity.ErrAuthorNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrAuthorLifeDates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrAuthorSelfMerge):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, entity.ErrBookNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPublisherNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrGenreNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrGenreExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrGenreCycle), errors.Is(err, entity.ErrGenreHasChildren):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrWorkNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrSeriesNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrSeriesVolumeTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrBookNotInSeries):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrCopyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCopyBarcodeExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrCopyOnLoan), errors.Is(err, entity.ErrCopyOnHold),
+		errors.Is(err, entity.ErrCopyRetired), errors.Is(err, entity.ErrCopyInTransit),
+		errors.Is(err, entity.ErrCopyAtOtherBranch):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrPatronNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPatronCardExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrPatronContactRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrLoanNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrLoanReturned), errors.Is(err, entity.ErrLoanPolicyNotFound),
+		errors.Is(err, entity.ErrLoanLimitReached), errors.Is(err, entity.ErrRenewalLimitReached),
+		errors.Is(err, entity.ErrLoanFined), errors.Is(err, entity.ErrLoanOverdue),
+		errors.Is(err, entity.ErrCopyNotAvailable), errors.Is(err, entity.ErrPatronBlocked),
+		errors.Is(err, entity.ErrMembershipExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrHoldNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrHoldExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrHoldNotNeeded), errors.Is(err, entity.ErrHoldClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrFineNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrFineClosed), errors.Is(err, entity.ErrFinePolicyNotFound),
+		errors.Is(err, entity.ErrFineBalanceExceeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrFineOverpaid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrBranchNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrBranchExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrTransferNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrTransferExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrTransferSameBranch), errors.Is(err, entity.ErrTransferNotRequested),
+		errors.Is(err, entity.ErrTransferNotInTransit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrReviewExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrBookISBNExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrBookInCirculation):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInvalidUpdateMask):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInvalidEtag):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrVersionMismatch):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+// getExisting looks the referenced ids up and returns the found ones, nothing is queried without references.
+func getExisting[T any](
+	ctx context.Context,
+	ids []string,
+	lookup func(context.Context, []string) ([]T, error),
+	getID func(T) string,
+) (map[string]struct{}, error) {
+	existing := make(map[string]struct{})
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	found, err := lookup(ctx, lo.Uniq(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range found {
+		existing[getID(item)] = struct{}{}
+	}
+
+	return existing, nil
+}
+
+func bookToProto(book entity.Book) *library.Book {
+	return &library.Book{
+		Id:        book.ID,
+		Name:      book.Name,
+		AuthorIds: book.AuthorIDs,
+		CreatedAt: timestamppb.New(book.CreatedAt),
+		UpdatedAt: timestamppb.New(book.UpdatedAt),
+		Etag:      formatEtag(book.Version),
+
+		Isbn:            book.ISBN,
+		PublicationYear: int32(book.PublicationYear),
+		Language:        book.Language,
+		PageCount:       int32(book.PageCount),
+		Description:     book.Description,
+		Subtitle:        book.Subtitle,
+		PublisherId:     book.PublisherID,
+		GenreIds:        book.GenreIDs,
+		WorkId:          book.WorkID,
+		Contributors:    contributorsToProto(book.Contributors),
+	}
+}
+
+func contributorsToProto(contributors []entity.Contributor) []*library.Contributor {
+	if contributors == nil {
+		return nil
+	}
+
+	result := make([]*library.Contributor, 0, len(contributors))
+	for _, contributor := range contributors {
+		result = append(result, &library.Contributor{
+			AuthorId: contributor.AuthorID,
+			Role:     library.ContributorRole(contributor.Role),
+			Position: int32(contributor.Position),
+		})
+	}
+
+	return result
+}
+
+// contributorsFromProto keeps the first role of every author, an unspecified role is an author.
+func contributorsFromProto(contributors []*library.Contributor) []entity.Contributor {
+	contributors = lo.UniqBy(contributors, func(contributor *library.Contributor) string {
+		return contributor.GetAuthorId()
+	})
+
+	result := make([]entity.Contributor, 0, len(contributors))
+	for i, contributor := range contributors {
+		role := entity.ContributorRole(contributor.GetRole())
+		if role == 0 {
+			role = entity.ContributorRoleAuthor
+		}
+
+		result = append(result, entity.Contributor{
+			AuthorID: contributor.GetAuthorId(),
+			Role:     role,
+			Position: i + 1,
+		})
+	}
+
+	return result
+}
+
+// requestAuthorIDs returns the authors of the request given either by ids or as contributors.
+func requestAuthorIDs(request *library.AddBookRequest) []string {
+	if len(request.GetContributors()) == 0 {
+		return request.GetAuthorIds()
+	}
+
+	return lo.Map(request.GetContributors(), func(contributor *library.Contributor, _ int) string {
+		return contributor.GetAuthorId()
+	})
+}
+
+func bookFromRequest(request *library.AddBookRequest) entity.Book {
+	book := entity.Book{
+		Name:            request.GetName(),
+		AuthorIDs:       requestAuthorIDs(request),
+		ISBN:            request.GetIsbn(),
+		PublicationYear: int(request.GetPublicationYear()),
+		Language:        request.GetLanguage(),
+		PageCount:       int(request.GetPageCount()),
+		Description:     request.GetDescription(),
+		Subtitle:        request.GetSubtitle(),
+		PublisherID:     request.GetPublisherId(),
+		GenreIDs:        request.GetGenreIds(),
+		WorkID:          request.GetWorkId(),
+	}
+
+	if len(request.GetContributors()) > 0 {
+		book.Contributors = contributorsFromProto(request.GetContributors())
+		book.AuthorIDs = lo.Map(book.Contributors, func(contributor entity.Contributor, _ int) string {
+			return contributor.AuthorID
+		})
+	}
+
+	return book
+}
+
+func genreToProto(genre entity.Genre) *library.Genre {
+	return &library.Genre{
+		Id:        genre.ID,
+		Name:      genre.Name,
+		ParentId:  genre.ParentID,
+		CreatedAt: timestamppb.New(genre.CreatedAt),
+		UpdatedAt: timestamppb.New(genre.UpdatedAt),
+	}
+}
+
+func workToProto(work entity.Work) *library.Work {
+	return &library.Work{
+		Id:        work.ID,
+		Name:      work.Name,
+		AuthorIds: work.AuthorIDs,
+		CreatedAt: timestamppb.New(work.CreatedAt),
+		UpdatedAt: timestamppb.New(work.UpdatedAt),
+	}
+}
+
+func seriesToProto(series entity.Series) *library.Series {
+	return &library.Series{
+		Id:          series.ID,
+		Name:        series.Name,
+		Description: series.Description,
+		CreatedAt:   timestamppb.New(series.CreatedAt),
+		UpdatedAt:   timestamppb.New(series.UpdatedAt),
+	}
+}
+
+func bookSeriesToProto(volume entity.SeriesVolume) *library.BookSeries {
+	return &library.BookSeries{
+		SeriesId:   volume.SeriesID,
+		SeriesName: volume.SeriesName,
+		Volume:     volume.Volume,
+	}
+}
+
+func copyToProto(bookCopy entity.Copy) *library.Copy {
+	return &library.Copy{
+		Id:              bookCopy.ID,
+		BookId:          bookCopy.BookID,
+		Barcode:         bookCopy.Barcode,
+		ShelfLocation:   bookCopy.ShelfLocation,
+		AcquiredOn:      timeToProto(bookCopy.AcquiredOn),
+		PriceCents:      bookCopy.PriceCents,
+		Status:          library.CopyStatus(bookCopy.Status),
+		RetiredAt:       timeToProto(bookCopy.RetiredAt),
+		CreatedAt:       timestamppb.New(bookCopy.CreatedAt),
+		UpdatedAt:       timestamppb.New(bookCopy.UpdatedAt),
+		HomeBranchId:    bookCopy.HomeBranchID,
+		CurrentBranchId: bookCopy.CurrentBranchID,
+	}
+}
+
+func patronToProto(patron entity.Patron) *library.Patron {
+	return &library.Patron{
+		Id:          patron.ID,
+		Name:        patron.Name,
+		Email:       patron.Email,
+		Phone:       patron.Phone,
+		CardNumber:  patron.CardNumber,
+		Tier:        library.MembershipTier(patron.Tier),
+		ExpiresOn:   timestamppb.New(patron.ExpiresOn),
+		Blocked:     patron.Blocked,
+		BlockReason: patron.BlockReason,
+		CreatedAt:   timestamppb.New(patron.CreatedAt),
+		UpdatedAt:   timestamppb.New(patron.UpdatedAt),
+		Etag:        formatEtag(patron.Version),
+	}
+}
+
+func loanToProto(loan entity.Loan) *library.Loan {
+	return &library.Loan{
+		Id:           loan.ID,
+		CopyId:       loan.CopyID,
+		BookId:       loan.BookID,
+		PatronId:     loan.PatronID,
+		CheckedOutAt: timestamppb.New(loan.CheckedOutAt),
+		DueAt:        timestamppb.New(loan.DueAt),
+		ReturnedAt:   timeToProto(loan.ReturnedAt),
+		Renewals:     int32(loan.Renewals),
+		BranchId:     loan.BranchID,
+	}
+}
+
+func holdToProto(hold entity.Hold) *library.Hold {
+	return &library.Hold{
+		Id:             hold.ID,
+		BookId:         hold.BookID,
+		PatronId:       hold.PatronID,
+		CopyId:         hold.CopyID,
+		Status:         library.HoldStatus(hold.Status),
+		Position:       int32(hold.Position),
+		ReadyUntil:     timeToProto(hold.ReadyUntil),
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+		UpdatedAt:      timestamppb.New(hold.UpdatedAt),
+		PickupBranchId: hold.PickupBranchID,
+	}
+}
+
+func branchToProto(branch entity.Branch) *library.Branch {
+	return &library.Branch{
+		Id:        branch.ID,
+		Name:      branch.Name,
+		Address:   branch.Address,
+		CreatedAt: timestamppb.New(branch.CreatedAt),
+		UpdatedAt: timestamppb.New(branch.UpdatedAt),
+	}
+}
+
+func reviewToProto(review entity.Review) *library.Review {
+	return &library.Review{
+		Id:             review.ID,
+		BookId:         review.BookID,
+		PatronId:       review.PatronID,
+		Rating:         int32(review.Rating),
+		Text:           review.Text,
+		Status:         library.ReviewStatus(review.Status),
+		ModerationNote: review.ModerationNote,
+		CreatedAt:      timestamppb.New(review.CreatedAt),
+		UpdatedAt:      timestamppb.New(review.UpdatedAt),
+	}
+}
+
+func transferToProto(transfer entity.Transfer) *library.Transfer {
+	return &library.Transfer{
+		Id:           transfer.ID,
+		CopyId:       transfer.CopyID,
+		FromBranchId: transfer.FromBranchID,
+		ToBranchId:   transfer.ToBranchID,
+		Status:       library.TransferStatus(transfer.Status),
+		ShippedAt:    timeToProto(transfer.ShippedAt),
+		ReceivedAt:   timeToProto(transfer.ReceivedAt),
+		CreatedAt:    timestamppb.New(transfer.CreatedAt),
+		UpdatedAt:    timestamppb.New(transfer.UpdatedAt),
+	}
+}
+
+func fineToProto(fine entity.Fine) *library.Fine {
+	history := make([]*library.FineEvent, 0, len(fine.Events))
+	for _, event := range fine.Events {
+		history = append(history, &library.FineEvent{
+			Kind:        library.FineEventKind(event.Kind),
+			AmountCents: event.AmountCents,
+			Note:        event.Note,
+			CreatedAt:   timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return &library.Fine{
+		Id:          fine.ID,
+		LoanId:      fine.LoanID,
+		PatronId:    fine.PatronID,
+		AmountCents: fine.AmountCents,
+		PaidCents:   fine.PaidCents,
+		Status:      library.FineStatus(fine.Status),
+		History:     history,
+		CreatedAt:   timestamppb.New(fine.CreatedAt),
+		UpdatedAt:   timestamppb.New(fine.UpdatedAt),
+	}
+}
+
+func publisherToProto(publisher entity.Publisher) *library.Publisher {
+	return &library.Publisher{
+		Id:        publisher.ID,
+		Name:      publisher.Name,
+		Country:   publisher.Country,
+		Website:   publisher.Website,
+		CreatedAt: timestamppb.New(publisher.CreatedAt),
+		UpdatedAt: timestamppb.New(publisher.UpdatedAt),
+		Etag:      formatEtag(publisher.Version),
+	}
+}
+
+func bookOrderFromProto(orderBy library.BookOrderBy) entity.BookOrderBy {
+	if orderBy == library.BookOrderBy_BOOK_ORDER_BY_CREATED_AT {
+		return entity.BookOrderByCreatedAt
+	}
+
+	return entity.BookOrderByName
+}
+
+func authorToProto(author entity.Author) *library.Author {
+	return &library.Author{
+		Id:          author.ID,
+		Name:        author.Name,
+		Etag:        formatEtag(author.Version),
+		BirthDate:   timeToProto(author.BirthDate),
+		DeathDate:   timeToProto(author.DeathDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     author.Aliases,
+	}
+}
+
+func formatEtag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// parseEtag accepts both the bare version and the quoted If-Match form, zero means no check.
+func parseEtag(etag string) (int64, error) {
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("%w: %q", entity.ErrInvalidEtag, etag)
+	}
+
+	return version, nil
+}
+
+// versionedIdempotencyKey identifies the message of a change of an entity, every change bumps the version, so
+// repeated changes get their own keys while the same change is never sent twice.
+func versionedIdempotencyKey(kind repository.OutboxKind, id string, version int64) string {
+	return kind.String() + "_" + id + "_" + strconv.FormatInt(version, 10)
+}
+
+// normalizeIDs lowercases ids, as postgres returns uuids, and drops duplicates keeping the order.
+func normalizeIDs(ids []string) []string {
+	return lo.Uniq(lo.Map(ids, func(id string, _ int) string {
+		return strings.ToLower(id)
+	}))
+}
+
+// uniqueAliases drops duplicate aliases keeping the order, no aliases stay nil.
+func uniqueAliases(aliases []string) []string {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	return lo.Uniq(aliases)
+}
+
+// getMaskPathsOrDefault is getMaskPaths where an empty mask stands for the defaults only, so that clients unaware
+// of the fields added later do not clear them.
+func getMaskPathsOrDefault(mask *fieldmaskpb.FieldMask, defaults []string, supported ...string) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return defaults, nil
+	}
+
+	return getMaskPaths(mask, supported...)
+}
+
+// getMaskPaths returns the paths to update, an empty mask stands for all supported paths.
+func getMaskPaths(mask *fieldmaskpb.FieldMask, supported ...string) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return supported, nil
+	}
+
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(supported, path) {
+			return nil, fmt.Errorf("%w: unsupported path %q", entity.ErrInvalidUpdateMask, path)
+		}
+	}
+
+	return mask.GetPaths(), nil
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
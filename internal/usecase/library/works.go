@@ -0,0 +1,27 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/generated/api/library"
+)
+
+func (l *libraryImpl) GetWork(ctx context.Context, request *library.GetWorkRequest) (*library.GetWorkResponse, error) {
+	l.logger.Info("Get work request is being made to the database.")
+	work, err := l.workRepository.GetWork(ctx, request.GetId())
+
+	if err != nil {
+		return nil, l.convertErr(err)
+	}
+
+	response := &library.GetWorkResponse{
+		Work:     workToProto(work),
+		Editions: make([]*library.Book, 0, len(work.Editions)),
+	}
+
+	for _, book := range work.Editions {
+		response.Editions = append(response.Editions, bookToProto(book))
+	}
+
+	return response, nil
+}
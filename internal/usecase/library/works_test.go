@@ -0,0 +1,76 @@
+package library
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/generated/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetWork(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.NewString()
+	work := entity.Work{
+		ID:        uuid.NewString(),
+		Name:      "War and Peace",
+		AuthorIDs: []string{authorID},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	work.Editions = []entity.Book{
+		{ID: uuid.NewString(), Name: "War and Peace", AuthorIDs: work.AuthorIDs, PublicationYear: 1869, WorkID: work.ID},
+		{ID: uuid.NewString(), Name: "Voina i mir", AuthorIDs: work.AuthorIDs, PublicationYear: 2007, WorkID: work.ID},
+	}
+
+	testCases := []struct {
+		name            string
+		repositoryError error
+		expectedError   error
+	}{
+		{
+			name: "Run without errors",
+		},
+		{
+			name:            "Run with not found errors",
+			repositoryError: entity.ErrWorkNotFound,
+			expectedError:   status.Error(codes.NotFound, "work not found"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+			workRepo := mocks.NewMockWorkRepository(ctrl)
+			workRepo.EXPECT().GetWork(ctx, work.ID).Return(work, tc.repositoryError)
+
+			uc := New(zap.NewNop(), nil, nil, nil, nil, nil, nil, nil, nil, workRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			resp, err := uc.GetWork(ctx, &library.GetWorkRequest{Id: work.ID})
+			if tc.expectedError != nil {
+				require.Equal(t, status.Code(tc.expectedError), status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, workToProto(work), resp.GetWork())
+			require.Len(t, resp.GetEditions(), len(work.Editions))
+			for i, edition := range resp.GetEditions() {
+				require.Equal(t, work.Editions[i].ID, edition.GetId())
+				require.Equal(t, work.ID, edition.GetWorkId())
+				require.Equal(t, []string{authorID}, edition.GetAuthorIds())
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package periodic
+
+import (
+	"context"
+	"time"
+)
+
+// Job is one pass of a background task, errors are logged by the job itself.
+type Job func(ctx context.Context)
+
+// Start runs job right away and then every interval until ctx is done.
+func Start(ctx context.Context, interval time.Duration, job Job) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	Run(ctx, ticker.C, job)
+}
+
+// Run runs job right away and then on every tick until ctx is done or ticks is closed.
+func Run(ctx context.Context, ticks <-chan time.Time, job Job) {
+	for {
+		job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-ticks:
+			if !ok {
+				return
+			}
+		}
+	}
+}
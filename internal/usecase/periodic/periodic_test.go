@@ -0,0 +1,70 @@
+package periodic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		ticks         int
+		cancel        bool
+		expectedCalls int
+	}{
+		{
+			name:          "run without ticks",
+			ticks:         0,
+			expectedCalls: 1,
+		},
+		{
+			name:          "run on every tick",
+			ticks:         3,
+			expectedCalls: 4,
+		},
+		{
+			name:          "run stops when context is done",
+			ticks:         2,
+			cancel:        true,
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ticks := make(chan time.Time)
+			calls := 0
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				Run(ctx, ticks, func(context.Context) {
+					calls++
+				})
+			}()
+
+			for range tc.ticks {
+				ticks <- time.Time{}
+			}
+
+			if tc.cancel {
+				cancel()
+			} else {
+				close(ticks)
+			}
+			<-done
+
+			require.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
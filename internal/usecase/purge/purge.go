@@ -0,0 +1,58 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/usecase/periodic"
+	"github.com/project/library/internal/usecase/repository"
+	"go.uber.org/zap"
+)
+
+type Purge interface {
+	Start(ctx context.Context, interval time.Duration, retention time.Duration)
+}
+
+var _ Purge = (*purgeImpl)(nil)
+
+type purgeImpl struct {
+	logger           *zap.Logger
+	authorRepository repository.AuthorRepository
+	booksRepository  repository.BooksRepository
+}
+
+func New(
+	logger *zap.Logger,
+	authorRepository repository.AuthorRepository,
+	booksRepository repository.BooksRepository,
+) *purgeImpl {
+	return &purgeImpl{
+		logger:           logger,
+		authorRepository: authorRepository,
+		booksRepository:  booksRepository,
+	}
+}
+
+func (p *purgeImpl) Start(ctx context.Context, interval time.Duration, retention time.Duration) {
+	periodic.Start(ctx, interval, func(ctx context.Context) {
+		p.purge(ctx, retention)
+	})
+}
+
+func (p *purgeImpl) purge(ctx context.Context, retention time.Duration) {
+	books, err := p.booksRepository.PurgeBooks(ctx, retention)
+
+	if err != nil {
+		p.logger.Error("can not purge deleted books", zap.Error(err))
+	} else {
+		p.logger.Info("deleted books purged", zap.Int64("count", books))
+	}
+
+	authors, err := p.authorRepository.PurgeAuthors(ctx, retention)
+
+	if err != nil {
+		p.logger.Error("can not purge deleted authors", zap.Error(err))
+	} else {
+		p.logger.Info("deleted authors purged", zap.Int64("count", authors))
+	}
+}
@@ -0,0 +1,56 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/mocks"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		retention    time.Duration
+		booksError   error
+		authorsError error
+	}{
+		{
+			name:      "purge without errors",
+			retention: time.Hour,
+		},
+		{
+			name:       "purge with books error",
+			retention:  time.Hour,
+			booksError: errors.New("test"),
+		},
+		{
+			name:         "purge with repository errors",
+			retention:    time.Hour,
+			booksError:   errors.New("test"),
+			authorsError: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			booksRepo := mocks.NewMockBooksRepository(ctrl)
+			booksRepo.EXPECT().PurgeBooks(ctx, tc.retention).Return(int64(1), tc.booksError)
+
+			authorRepo := mocks.NewMockAuthorRepository(ctrl)
+			authorRepo.EXPECT().PurgeAuthors(ctx, tc.retention).Return(int64(1), tc.authorsError)
+
+			New(zap.NewNop(), authorRepo, booksRepo).purge(ctx, tc.retention)
+		})
+	}
+}
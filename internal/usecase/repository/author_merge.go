@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+// FindDuplicateAuthors compares the names with pg_trgm, the % operator keeps the trigram index usable, so pairs
+// below pg_trgm.similarity_threshold are never found.
+func (r *postgresImpl) FindDuplicateAuthors(ctx context.Context, params entity.FindDuplicateAuthorsParams) ([]entity.DuplicateAuthors, error) {
+	args := []any{params.MinSimilarity}
+	conditions := []string{
+		"a.deleted_at IS NULL", "d.deleted_at IS NULL", "a.id < d.id", "a.name % d.name",
+		"similarity(a.name, d.name) >= $1",
+	}
+
+	if params.AuthorID != "" {
+		args = append(args, params.AuthorID)
+		authorID := resolvedAuthorID("$2")
+		conditions = append(conditions, "(a.id = "+authorID+" OR d.id = "+authorID+")")
+	}
+
+	args = append(args, params.Limit)
+	query := fmt.Sprintf(`
+		SELECT a.id, a.name, d.id, d.name, similarity(a.name, d.name) AS score
+		FROM author a
+		JOIN author d ON %s
+		ORDER BY score DESC, a.id, d.id
+		LIMIT $%d
+		`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	duplicates := make([]entity.DuplicateAuthors, 0, params.Limit)
+
+	for rows.Next() {
+		var pair entity.DuplicateAuthors
+		if err := rows.Scan(&pair.AuthorID, &pair.AuthorName, &pair.DuplicateID, &pair.DuplicateName,
+			&pair.Similarity); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		duplicates = append(duplicates, pair)
+	}
+
+	return duplicates, rows.Err()
+}
+
+// MergeAuthors is expected to run in a transaction. The works of the sources move to the target, keeping the role
+// of the target where it already contributed, the names and aliases of the sources become aliases of the target and
+// the sources are deleted, their ids redirect to the target. The editions of the moved works are bumped, as their
+// authors changed, and returned with the target as their author.
+func (r *postgresImpl) MergeAuthors(ctx context.Context, sourceIDs []string, targetID string) (entity.AuthorMerge, error) {
+	const (
+		queryLock = `
+SELECT ` + authorColumns + `
+FROM author
+WHERE id = ANY($1) AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE`
+		queryWorks = `
+INSERT INTO author_work (author_id, work_id, role, position)
+SELECT $2, work_id, role, position
+FROM author_work
+WHERE author_id = ANY($1)
+ON CONFLICT (author_id, work_id) DO NOTHING`
+		queryEditions = `
+UPDATE book
+SET name = name
+WHERE work_id IN (SELECT work_id FROM author_work WHERE author_id = ANY($1)) AND deleted_at IS NULL
+RETURNING id`
+		queryContributions = `DELETE FROM author_work WHERE author_id = ANY($1)`
+		queryRedirects     = `UPDATE author_redirect SET author_id = $2 WHERE author_id = ANY($1)`
+		queryRedirect      = `INSERT INTO author_redirect (id, author_id) SELECT unnest($1::uuid[]), $2`
+		queryAliases       = `UPDATE author SET aliases = $2 WHERE id = $1 RETURNING ` + authorColumns
+	)
+
+	q := r.getQuerier(ctx)
+
+	// The rows are locked in the order of ids, so concurrent merges of overlapping authors do not deadlock.
+	rows, err := q.Query(ctx, queryLock, append([]string{targetID}, sourceIDs...))
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.AuthorMerge{}, err
+	}
+
+	defer rows.Close()
+
+	var (
+		merge  entity.AuthorMerge
+		target *entity.Author
+	)
+
+	for rows.Next() {
+		var author entity.Author
+		if err := rows.Scan(authorFields(&author)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return entity.AuthorMerge{}, err
+		}
+
+		if author.ID == targetID {
+			target = &author
+		} else {
+			merge.Sources = append(merge.Sources, author)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error while working with row.", zap.Error(err))
+		return entity.AuthorMerge{}, err
+	}
+
+	if target == nil || len(merge.Sources) != len(sourceIDs) {
+		return entity.AuthorMerge{}, entity.ErrAuthorNotFound
+	}
+
+	aliases := mergeAliases(*target, merge.Sources)
+
+	if _, err := q.Exec(ctx, queryWorks, sourceIDs, targetID); err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	editions, err := q.Query(ctx, queryEditions, sourceIDs)
+	if err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	defer editions.Close()
+
+	var bookIDs []string
+
+	for editions.Next() {
+		var bookID string
+		if err := editions.Scan(&bookID); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return entity.AuthorMerge{}, err
+		}
+		bookIDs = append(bookIDs, bookID)
+	}
+
+	if err := editions.Err(); err != nil {
+		r.logger.Error("Error while working with row.", zap.Error(err))
+		return entity.AuthorMerge{}, err
+	}
+
+	if _, err := q.Exec(ctx, queryContributions, sourceIDs); err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	// Earlier merges into the sources now redirect to the target.
+	if _, err := q.Exec(ctx, queryRedirects, sourceIDs, targetID); err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	if err := r.deleteMergedAuthors(ctx, merge.Sources); err != nil {
+		return entity.AuthorMerge{}, err
+	}
+
+	if _, err := q.Exec(ctx, queryRedirect, sourceIDs, targetID); err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	err = q.QueryRow(ctx, queryAliases, targetID, aliases).Scan(authorFields(&merge.Target)...)
+	if err != nil {
+		return entity.AuthorMerge{}, r.mapErr(err)
+	}
+
+	// The editions are read once the sources are gone, so their contributors are the ones after the merge.
+	if len(bookIDs) > 0 {
+		if merge.Books, err = r.GetBooksInfo(ctx, bookIDs); err != nil {
+			return entity.AuthorMerge{}, err
+		}
+	}
+
+	return merge, nil
+}
+
+// deleteMergedAuthors sets the versions and deletion times of the deleted sources, like DeleteAuthor returns them.
+func (r *postgresImpl) deleteMergedAuthors(ctx context.Context, sources []entity.Author) error {
+	const query = `UPDATE author SET deleted_at = now() WHERE id = ANY($1) RETURNING id, version, deleted_at`
+
+	indexes := make(map[string]int, len(sources))
+	ids := make([]string, len(sources))
+
+	for i, source := range sources {
+		indexes[source.ID] = i
+		ids[i] = source.ID
+	}
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, ids)
+	if err != nil {
+		return r.mapErr(err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id      string
+			version int64
+			deleted time.Time
+		)
+
+		if err := rows.Scan(&id, &version, &deleted); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return err
+		}
+
+		sources[indexes[id]].Version = version
+		sources[indexes[id]].DeletedAt = &deleted
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error while working with row.", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// mergeAliases appends the names and aliases of the sources to the aliases of the target, skipping the repeated ones.
+func mergeAliases(target entity.Author, sources []entity.Author) []string {
+	seen := map[string]bool{target.Name: true}
+	aliases := make([]string, 0, len(target.Aliases)+len(sources))
+
+	add := func(alias string) {
+		if !seen[alias] {
+			seen[alias] = true
+			aliases = append(aliases, alias)
+		}
+	}
+
+	for _, alias := range target.Aliases {
+		add(alias)
+	}
+
+	for _, source := range sources {
+		add(source.Name)
+
+		for _, alias := range source.Aliases {
+			add(alias)
+		}
+	}
+
+	return aliases
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ BranchRepository = (*postgresImpl)(nil)
+
+const branchColumns = `id, name, COALESCE(address, ''), created_at, updated_at`
+
+func branchFields(branch *entity.Branch) []any {
+	return []any{&branch.ID, &branch.Name, &branch.Address, &branch.CreatedAt, &branch.UpdatedAt}
+}
+
+func (r *postgresImpl) CreateBranch(ctx context.Context, branch entity.Branch) (entity.Branch, error) {
+	const query = `INSERT INTO branch (name, address) VALUES ($1, $2) RETURNING ` + branchColumns
+
+	var result entity.Branch
+	err := r.getQuerier(ctx).QueryRow(ctx, query, branch.Name, nullIfZero(branch.Address)).Scan(branchFields(&result)...)
+	if err != nil {
+		return entity.Branch{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) ListBranches(ctx context.Context) ([]entity.Branch, error) {
+	const query = `SELECT ` + branchColumns + ` FROM branch ORDER BY name`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	branches := make([]entity.Branch, 0)
+
+	for rows.Next() {
+		var branch entity.Branch
+		if err := rows.Scan(branchFields(&branch)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		branches = append(branches, branch)
+	}
+
+	return branches, rows.Err()
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	changesChannel        = "library_changes"
+	changesReconnectDelay = time.Second
+)
+
+var _ ChangeLogRepository = (*changeLogRepository)(nil)
+
+type changeLogRepository struct {
+	db     *pgxpool.Pool
+	logger *zap.Logger
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewChangeLog(db *pgxpool.Pool, logger *zap.Logger) *changeLogRepository {
+	return &changeLogRepository{
+		db:          db,
+		logger:      logger,
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+// changeTime parses timestamps serialized by postgres without a time zone.
+type changeTime struct {
+	time.Time
+}
+
+func (c *changeTime) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse("2006-01-02T15:04:05.999999", raw)
+	if err != nil {
+		return err
+	}
+
+	c.Time = parsed
+	return nil
+}
+
+type changeData struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	AuthorIDs []string   `json:"author_ids"`
+	CreatedAt changeTime `json:"created_at"`
+	UpdatedAt changeTime `json:"updated_at"`
+	Version   int64      `json:"version"`
+
+	ISBN            string   `json:"isbn"`
+	PublicationYear int      `json:"publication_year"`
+	Language        string   `json:"language"`
+	PageCount       int      `json:"page_count"`
+	Description     string   `json:"description"`
+	Subtitle        string   `json:"subtitle"`
+	PublisherID     string   `json:"publisher_id"`
+	GenreIDs        []string `json:"genre_ids"`
+	WorkID          string   `json:"work_id"`
+	// Contributors are missing from the changes logged before the author roles.
+	Contributors []contributorRow `json:"contributors"`
+
+	BirthDate   *changeTime `json:"birth_date"`
+	DeathDate   *changeTime `json:"death_date"`
+	Nationality string      `json:"nationality"`
+	Biography   string      `json:"biography"`
+	Aliases     []string    `json:"aliases"`
+}
+
+func (c *changeTime) timePtr() *time.Time {
+	if c == nil {
+		return nil
+	}
+
+	return &c.Time
+}
+
+// GetChanges publishes the pending entries first, only published entries have a sequence.
+func (c *changeLogRepository) GetChanges(ctx context.Context, fromSequence int64, limit int) ([]entity.Change, error) {
+	if _, err := c.db.Exec(ctx, `SELECT publish_changes()`); err != nil {
+		c.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	const query = `
+SELECT sequence, kind, operation, data, created_at
+FROM change_log
+WHERE sequence >= $1
+ORDER BY sequence
+LIMIT $2`
+
+	rows, err := c.db.Query(ctx, query, fromSequence, limit)
+	if err != nil {
+		c.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	changes := make([]entity.Change, 0, limit)
+
+	for rows.Next() {
+		var (
+			change entity.Change
+			raw    []byte
+			data   changeData
+		)
+
+		if err := rows.Scan(&change.Sequence, &change.Kind, &change.Operation, &raw, &change.CreatedAt); err != nil {
+			c.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("can not deserialize change %d: %w", change.Sequence, err)
+		}
+
+		switch change.Kind {
+		case entity.ChangeKindBook:
+			change.Book = &entity.Book{
+				ID:        data.ID,
+				Name:      data.Name,
+				AuthorIDs: data.AuthorIDs,
+				CreatedAt: data.CreatedAt.Time,
+				UpdatedAt: data.UpdatedAt.Time,
+				Version:   data.Version,
+
+				ISBN:            data.ISBN,
+				PublicationYear: data.PublicationYear,
+				Language:        data.Language,
+				PageCount:       data.PageCount,
+				Description:     data.Description,
+				Subtitle:        data.Subtitle,
+				PublisherID:     data.PublisherID,
+				GenreIDs:        data.GenreIDs,
+				WorkID:          data.WorkID,
+			}
+
+			if data.Contributors != nil {
+				setContributors(change.Book, data.Contributors)
+			}
+		case entity.ChangeKindAuthor:
+			change.Author = &entity.Author{
+				ID:          data.ID,
+				Name:        data.Name,
+				Version:     data.Version,
+				BirthDate:   data.BirthDate.timePtr(),
+				DeathDate:   data.DeathDate.timePtr(),
+				Nationality: data.Nationality,
+				Biography:   data.Biography,
+				Aliases:     data.Aliases,
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (c *changeLogRepository) SubscribeChanges() (<-chan struct{}, func()) {
+	notifications := make(chan struct{}, 1)
+
+	c.mu.Lock()
+	c.subscribers[notifications] = struct{}{}
+	c.mu.Unlock()
+
+	return notifications, func() {
+		c.mu.Lock()
+		delete(c.subscribers, notifications)
+		c.mu.Unlock()
+	}
+}
+
+// Listen wakes up subscribers on every committed change until ctx is done.
+func (c *changeLogRepository) Listen(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := c.listen(ctx)
+
+		if err != nil && ctx.Err() == nil {
+			c.logger.Error("Error while listening to changes.", zap.Error(err))
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(changesReconnectDelay):
+			}
+		}
+	}
+}
+
+func (c *changeLogRepository) listen(ctx context.Context) error {
+	conn, err := c.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The listening connection never goes back to the pool.
+	pgConn := conn.Hijack()
+	defer pgConn.Close(context.Background())
+
+	if _, err = pgConn.Exec(ctx, "LISTEN "+changesChannel); err != nil {
+		return err
+	}
+
+	// Changes committed while reconnecting were not announced.
+	c.notify()
+
+	for {
+		if _, err = pgConn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+
+		c.notify()
+	}
+}
+
+func (c *changeLogRepository) notify() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for subscriber := range c.subscribers {
+		select {
+		case subscriber <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ CopyRepository = (*postgresImpl)(nil)
+
+const copyColumns = `id, book_id, home_branch_id, current_branch_id, barcode, COALESCE(shelf_location, ''), acquired_on,
+		COALESCE(price_cents, 0), status, retired_at, created_at, updated_at`
+
+func copyFields(bookCopy *entity.Copy) []any {
+	return []any{
+		&bookCopy.ID, &bookCopy.BookID, &bookCopy.HomeBranchID, &bookCopy.CurrentBranchID, &bookCopy.Barcode,
+		&bookCopy.ShelfLocation, &bookCopy.AcquiredOn, &bookCopy.PriceCents, &bookCopy.Status, &bookCopy.RetiredAt,
+		&bookCopy.CreatedAt, &bookCopy.UpdatedAt,
+	}
+}
+
+// AddCopy puts a copy without a home branch to the main branch created with the branches.
+func (r *postgresImpl) AddCopy(ctx context.Context, bookCopy entity.Copy) (entity.Copy, error) {
+	const query = `
+INSERT INTO copy (book_id, barcode, shelf_location, acquired_on, price_cents, home_branch_id, current_branch_id)
+SELECT b.id, $2, $3, $4, $5, home.id, home.id
+FROM book b,
+     (SELECT COALESCE($6::uuid, (SELECT id FROM branch WHERE name = 'Main')) AS id) home
+WHERE b.id = $1 AND b.deleted_at IS NULL
+RETURNING ` + copyColumns
+
+	var result entity.Copy
+	err := r.getQuerier(ctx).QueryRow(ctx, query, bookCopy.BookID, bookCopy.Barcode, nullIfZero(bookCopy.ShelfLocation),
+		bookCopy.AcquiredOn, nullIfZero(bookCopy.PriceCents), nullIfZero(bookCopy.HomeBranchID)).
+		Scan(copyFields(&result)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Copy{}, entity.ErrBookNotFound
+	}
+	if err != nil {
+		return entity.Copy{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) GetCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error) {
+	const query = `SELECT ` + copyColumns + ` FROM copy WHERE barcode = $1`
+
+	var bookCopy entity.Copy
+	err := r.getQuerier(ctx).QueryRow(ctx, query, barcode).Scan(copyFields(&bookCopy)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Copy{}, entity.ErrCopyNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Copy{}, err
+	}
+
+	return bookCopy, nil
+}
+
+// lockCopy locks a copy that is neither retired nor lent, held or in transit, only such copies can be changed by staff.
+func (r *postgresImpl) lockCopy(ctx context.Context, id string) error {
+	const query = `SELECT status, retired_at IS NOT NULL FROM copy WHERE id = $1 FOR UPDATE`
+
+	var (
+		status  entity.CopyStatus
+		retired bool
+	)
+
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(&status, &retired)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrCopyNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+
+	switch {
+	case retired:
+		return entity.ErrCopyRetired
+	case status == entity.CopyStatusOnLoan:
+		return entity.ErrCopyOnLoan
+	case status == entity.CopyStatusOnHold:
+		return entity.ErrCopyOnHold
+	case status == entity.CopyStatusInTransit:
+		return entity.ErrCopyInTransit
+	}
+
+	return nil
+}
+
+// SetCopyStatus and RetireCopy lock the copy, so they are expected to run in a transaction.
+func (r *postgresImpl) SetCopyStatus(ctx context.Context, id string, status entity.CopyStatus) (entity.Copy, error) {
+	const query = `UPDATE copy SET status = $2 WHERE id = $1 RETURNING ` + copyColumns
+
+	if err := r.lockCopy(ctx, id); err != nil {
+		return entity.Copy{}, err
+	}
+
+	var bookCopy entity.Copy
+	if err := r.getQuerier(ctx).QueryRow(ctx, query, id, status).Scan(copyFields(&bookCopy)...); err != nil {
+		return entity.Copy{}, r.mapErr(err)
+	}
+
+	return bookCopy, nil
+}
+
+func (r *postgresImpl) RetireCopy(ctx context.Context, id string) (entity.Copy, error) {
+	const query = `UPDATE copy SET retired_at = now() WHERE id = $1 RETURNING ` + copyColumns
+
+	if err := r.lockCopy(ctx, id); err != nil {
+		return entity.Copy{}, err
+	}
+
+	var bookCopy entity.Copy
+	if err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(copyFields(&bookCopy)...); err != nil {
+		return entity.Copy{}, r.mapErr(err)
+	}
+
+	return bookCopy, nil
+}
+
+// GetBranchCopyCounts counts the copies of the book by the branch they are at, branches without copies are omitted.
+func (r *postgresImpl) GetBranchCopyCounts(ctx context.Context, bookID string) ([]entity.BranchCopyCounts, error) {
+	const query = `
+SELECT br.id, br.name, count(*), count(*) FILTER (WHERE c.status = $2)
+FROM copy c
+         JOIN branch br ON br.id = c.current_branch_id
+WHERE c.book_id = $1 AND c.retired_at IS NULL
+GROUP BY br.id, br.name
+ORDER BY br.name`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, bookID, entity.CopyStatusAvailable)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	counts := make([]entity.BranchCopyCounts, 0)
+
+	for rows.Next() {
+		var branch entity.BranchCopyCounts
+		if err := rows.Scan(&branch.BranchID, &branch.BranchName, &branch.Total, &branch.Available); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		counts = append(counts, branch)
+	}
+
+	return counts, rows.Err()
+}
+
+// LockCopy, LockCopyByBarcode and LockAvailableCopy lock the copy for circulation, they are expected to run in a
+// transaction.
+func (r *postgresImpl) LockCopy(ctx context.Context, id string) (entity.Copy, error) {
+	const query = `SELECT ` + copyColumns + ` FROM copy WHERE id = $1 FOR UPDATE`
+
+	return r.lockCirculatingCopy(ctx, query, id)
+}
+
+// LockCopyByBarcode returns ErrBookNotFound for a copy of a deleted book. The book is read after the copy is locked,
+// so a concurrent DeleteBook either commits first and is seen here or sees the loan made with the copy.
+func (r *postgresImpl) LockCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error) {
+	const (
+		query     = `SELECT ` + copyColumns + ` FROM copy WHERE barcode = $1 FOR UPDATE`
+		queryBook = `SELECT EXISTS (SELECT 1 FROM book WHERE id = $1 AND deleted_at IS NULL)`
+	)
+
+	bookCopy, err := r.lockCirculatingCopy(ctx, query, barcode)
+	if err != nil {
+		return entity.Copy{}, err
+	}
+
+	var exists bool
+	if err := r.getQuerier(ctx).QueryRow(ctx, queryBook, bookCopy.BookID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Copy{}, err
+	}
+	if !exists {
+		return entity.Copy{}, entity.ErrBookNotFound
+	}
+
+	return bookCopy, nil
+}
+
+// LockAvailableCopy locks any available copy of the book, copies locked by other transactions are skipped.
+func (r *postgresImpl) LockAvailableCopy(ctx context.Context, bookID string) (entity.Copy, error) {
+	const query = `
+SELECT ` + copyColumns + `
+FROM copy
+WHERE book_id = $1 AND status = $2 AND retired_at IS NULL
+ORDER BY id
+LIMIT 1
+FOR UPDATE SKIP LOCKED`
+
+	return r.lockCirculatingCopy(ctx, query, bookID, entity.CopyStatusAvailable)
+}
+
+func (r *postgresImpl) lockCirculatingCopy(ctx context.Context, query string, args ...any) (entity.Copy, error) {
+	var bookCopy entity.Copy
+	err := r.getQuerier(ctx).QueryRow(ctx, query, args...).Scan(copyFields(&bookCopy)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Copy{}, entity.ErrCopyNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Copy{}, err
+	}
+
+	return bookCopy, nil
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ FineRepository = (*postgresImpl)(nil)
+
+const fineColumns = `f.id, f.loan_id, f.patron_id, f.amount_cents, f.paid_cents, f.status, f.created_at, f.updated_at`
+
+func fineFields(fine *entity.Fine) []any {
+	return []any{
+		&fine.ID, &fine.LoanID, &fine.PatronID, &fine.AmountCents, &fine.PaidCents, &fine.Status, &fine.CreatedAt,
+		&fine.UpdatedAt,
+	}
+}
+
+// assessFinesQuery recalculates fines of the loans matching the condition. Days past due beyond the grace period are
+// charged at the daily rate up to the cap, settled fines and fines that did not change are left as they are.
+// A returned loan with an accruing fine always closes it, even when a renewal left nothing to charge any more.
+func assessFinesQuery(condition string) string {
+	return `
+WITH assessed AS (
+    SELECT l.id AS loan_id,
+           l.patron_id,
+           CASE WHEN l.returned_at IS NULL THEN $1 ELSE $2 END AS status,
+           LEAST(COALESCE(t.max_fine_cents, d.max_fine_cents),
+                 GREATEST(0, (COALESCE(l.returned_at, now())::date - l.due_at::date -
+                              COALESCE(t.grace_days, d.grace_days)) *
+                             COALESCE(t.daily_rate_cents, d.daily_rate_cents))) AS amount_cents
+    FROM loan l
+             JOIN patron p ON p.id = l.patron_id
+             JOIN fine_policy d ON d.tier = 0
+             LEFT JOIN fine_policy t ON t.tier = p.tier
+    WHERE ` + condition + `
+), f AS (
+    INSERT INTO fine (loan_id, patron_id, amount_cents, status)
+    SELECT loan_id, patron_id, amount_cents, status
+    FROM assessed
+    WHERE amount_cents > 0 OR EXISTS (SELECT 1 FROM fine WHERE fine.loan_id = assessed.loan_id)
+    ON CONFLICT (loan_id) DO UPDATE
+        SET amount_cents = GREATEST(fine.amount_cents, EXCLUDED.amount_cents),
+            status       = CASE
+                               WHEN EXCLUDED.status = $2
+                                   AND fine.paid_cents >= GREATEST(fine.amount_cents, EXCLUDED.amount_cents) THEN $3
+                               ELSE EXCLUDED.status END
+    WHERE fine.status = $1
+      AND (fine.amount_cents < EXCLUDED.amount_cents OR fine.status <> EXCLUDED.status)
+    RETURNING fine.*
+), e AS (
+    INSERT INTO fine_event (fine_id, kind, amount_cents) SELECT id, $4, amount_cents FROM f
+)`
+}
+
+func (r *postgresImpl) GetFinePolicy(ctx context.Context, tier entity.MembershipTier) (entity.FinePolicy, error) {
+	const query = `
+SELECT COALESCE(t.daily_rate_cents, d.daily_rate_cents),
+       COALESCE(t.grace_days, d.grace_days),
+       COALESCE(t.max_fine_cents, d.max_fine_cents),
+       COALESCE(t.block_threshold_cents, d.block_threshold_cents)
+FROM fine_policy d
+         LEFT JOIN fine_policy t ON t.tier = $1
+WHERE d.tier = 0`
+
+	policy := entity.FinePolicy{Tier: tier}
+	err := r.getQuerier(ctx).QueryRow(ctx, query, tier).
+		Scan(&policy.DailyRateCents, &policy.GraceDays, &policy.MaxFineCents, &policy.BlockThresholdCents)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.FinePolicy{}, entity.ErrFinePolicyNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.FinePolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// AssessLoanFine charges a late returned loan, ErrFineNotFound means there is nothing to charge.
+func (r *postgresImpl) AssessLoanFine(ctx context.Context, loanID string) (entity.Fine, error) {
+	query := assessFinesQuery("l.id = $5") + `
+SELECT ` + fineColumns + ` FROM f`
+
+	var fine entity.Fine
+	err := r.getQuerier(ctx).QueryRow(ctx, query, entity.FineStatusAccruing, entity.FineStatusUnpaid,
+		entity.FineStatusPaid, entity.FineEventAssessed, loanID).Scan(fineFields(&fine)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Fine{}, entity.ErrFineNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Fine{}, err
+	}
+
+	events, err := r.getFineEvents(ctx, []string{fine.ID})
+	if err != nil {
+		return entity.Fine{}, err
+	}
+
+	fine.Events = events[fine.ID]
+
+	return fine, nil
+}
+
+// GetLoanFine returns the fine of the loan, ErrFineNotFound means the loan has not been charged.
+func (r *postgresImpl) GetLoanFine(ctx context.Context, loanID string) (entity.Fine, error) {
+	const query = `SELECT ` + fineColumns + ` FROM fine f WHERE f.loan_id = $1`
+
+	var fine entity.Fine
+	err := r.getQuerier(ctx).QueryRow(ctx, query, loanID).Scan(fineFields(&fine)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Fine{}, entity.ErrFineNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Fine{}, err
+	}
+
+	return fine, nil
+}
+
+// AssessOverdueFines updates accruing fines of loans that are still out and returns how many fines changed.
+func (r *postgresImpl) AssessOverdueFines(ctx context.Context) (int64, error) {
+	query := assessFinesQuery("l.returned_at IS NULL AND l.due_at < now()") + `
+SELECT count(*) FROM f`
+
+	var count int64
+	err := r.getQuerier(ctx).QueryRow(ctx, query, entity.FineStatusAccruing, entity.FineStatusUnpaid,
+		entity.FineStatusPaid, entity.FineEventAssessed).Scan(&count)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetFineBalance returns the outstanding amount of accruing and unpaid fines of the patron.
+func (r *postgresImpl) GetFineBalance(ctx context.Context, patronID string) (int64, error) {
+	const query = `
+SELECT COALESCE(sum(amount_cents - paid_cents), 0)
+FROM fine
+WHERE patron_id = $1 AND status IN ($2, $3)`
+
+	var balance int64
+	err := r.getQuerier(ctx).QueryRow(ctx, query, patronID, entity.FineStatusAccruing, entity.FineStatusUnpaid).
+		Scan(&balance)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+func (r *postgresImpl) ListFines(ctx context.Context, patronID string) ([]entity.Fine, error) {
+	const query = `SELECT ` + fineColumns + ` FROM fine f WHERE f.patron_id = $1 ORDER BY f.created_at DESC, f.id`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, patronID)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	fines := make([]entity.Fine, 0)
+	ids := make([]string, 0)
+
+	for rows.Next() {
+		var fine entity.Fine
+		if err := rows.Scan(fineFields(&fine)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		fines = append(fines, fine)
+		ids = append(ids, fine.ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error while working with row.", zap.Error(err))
+		return nil, err
+	}
+
+	events, err := r.getFineEvents(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fines {
+		fines[i].Events = events[fines[i].ID]
+	}
+
+	return fines, nil
+}
+
+// LockFine is expected to run in a transaction.
+func (r *postgresImpl) LockFine(ctx context.Context, id string) (entity.Fine, error) {
+	const query = `SELECT ` + fineColumns + ` FROM fine f WHERE f.id = $1 FOR UPDATE`
+
+	var fine entity.Fine
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(fineFields(&fine)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Fine{}, entity.ErrFineNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Fine{}, err
+	}
+
+	return fine, nil
+}
+
+// PayFine records a payment, an unpaid fine becomes paid once it is paid in full.
+func (r *postgresImpl) PayFine(ctx context.Context, id string, amountCents int64) (entity.Fine, error) {
+	const query = `
+WITH f AS (
+    UPDATE fine
+    SET paid_cents = paid_cents + $2,
+        status     = CASE WHEN status = $3 AND paid_cents + $2 >= amount_cents THEN $4 ELSE status END
+    WHERE id = $1
+    RETURNING *
+), e AS (
+    INSERT INTO fine_event (fine_id, kind, amount_cents) SELECT id, $5, $2 FROM f
+)
+SELECT ` + fineColumns + ` FROM f`
+
+	return r.updateFine(ctx, query, id, amountCents, entity.FineStatusUnpaid, entity.FineStatusPaid,
+		entity.FineEventPaid)
+}
+
+// WaiveFine closes the fine, the waived outstanding amount is recorded with the reason.
+func (r *postgresImpl) WaiveFine(ctx context.Context, id string, reason string) (entity.Fine, error) {
+	const query = `
+WITH f AS (
+    UPDATE fine SET status = $2 WHERE id = $1 RETURNING *
+), e AS (
+    INSERT INTO fine_event (fine_id, kind, amount_cents, note) SELECT id, $3, amount_cents - paid_cents, $4 FROM f
+)
+SELECT ` + fineColumns + ` FROM f`
+
+	return r.updateFine(ctx, query, id, entity.FineStatusWaived, entity.FineEventWaived, reason)
+}
+
+func (r *postgresImpl) updateFine(ctx context.Context, query string, id string, args ...any) (entity.Fine, error) {
+	var fine entity.Fine
+	err := r.getQuerier(ctx).QueryRow(ctx, query, append([]any{id}, args...)...).Scan(fineFields(&fine)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Fine{}, entity.ErrFineNotFound
+	}
+	if err != nil {
+		return entity.Fine{}, r.mapErr(err)
+	}
+
+	events, err := r.getFineEvents(ctx, []string{fine.ID})
+	if err != nil {
+		return entity.Fine{}, err
+	}
+
+	fine.Events = events[fine.ID]
+
+	return fine, nil
+}
+
+func (r *postgresImpl) getFineEvents(ctx context.Context, ids []string) (map[string][]entity.FineEvent, error) {
+	const query = `
+SELECT fine_id, kind, amount_cents, note, created_at
+FROM fine_event
+WHERE fine_id = ANY($1)
+ORDER BY id`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	events := make(map[string][]entity.FineEvent)
+
+	for rows.Next() {
+		var (
+			fineID string
+			event  entity.FineEvent
+		)
+		if err := rows.Scan(&fineID, &event.Kind, &event.AmountCents, &event.Note, &event.CreatedAt); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		events[fineID] = append(events[fineID], event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ GenreRepository = (*postgresImpl)(nil)
+
+const genreColumns = `id, name, COALESCE(parent_id::text, ''), created_at, updated_at`
+
+func genreFields(genre *entity.Genre) []any {
+	return []any{&genre.ID, &genre.Name, &genre.ParentID, &genre.CreatedAt, &genre.UpdatedAt}
+}
+
+func (r *postgresImpl) CreateGenre(ctx context.Context, genre entity.Genre) (entity.Genre, error) {
+	const query = `INSERT INTO genre (name, parent_id) VALUES ($1, $2) RETURNING ` + genreColumns
+
+	var result entity.Genre
+	err := r.getQuerier(ctx).QueryRow(ctx, query, genre.Name, nullIfZero(genre.ParentID)).Scan(genreFields(&result)...)
+	if err != nil {
+		return entity.Genre{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) UpdateGenre(ctx context.Context, update entity.GenreUpdate) (entity.Genre, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entity.Genre{}, err
+	}
+
+	defer r.txRollback(ctx, tx)
+
+	const queryLock = `SELECT id FROM genre WHERE id = $1 FOR UPDATE`
+
+	err = tx.QueryRow(ctx, queryLock, update.ID).Scan(&update.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Genre{}, entity.ErrGenreNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Genre{}, err
+	}
+
+	if update.ParentID != nil && *update.ParentID != "" {
+		const queryCycle = `
+WITH RECURSIVE subtree AS (
+    SELECT id FROM genre WHERE id = $1
+    UNION ALL
+    SELECT g.id FROM genre g JOIN subtree s ON g.parent_id = s.id
+)
+SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)`
+
+		var cycle bool
+		if err := tx.QueryRow(ctx, queryCycle, update.ID, *update.ParentID).Scan(&cycle); err != nil {
+			r.logger.Error("Error while accessing to data base.", zap.Error(err))
+			return entity.Genre{}, err
+		}
+		if cycle {
+			return entity.Genre{}, entity.ErrGenreCycle
+		}
+	}
+
+	args := []any{update.ID}
+	sets := []string{"updated_at = now()"}
+
+	if update.Name != nil {
+		args = append(args, *update.Name)
+		sets = append(sets, "name = $"+strconv.Itoa(len(args)))
+	}
+	if update.ParentID != nil {
+		args = append(args, nullIfZero(*update.ParentID))
+		sets = append(sets, "parent_id = $"+strconv.Itoa(len(args))+"::uuid")
+	}
+
+	query := "UPDATE genre SET " + strings.Join(sets, ", ") + " WHERE id = $1 RETURNING " + genreColumns
+
+	var genre entity.Genre
+	if err := tx.QueryRow(ctx, query, args...).Scan(genreFields(&genre)...); err != nil {
+		return entity.Genre{}, r.mapErr(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entity.Genre{}, err
+	}
+
+	return genre, nil
+}
+
+func (r *postgresImpl) DeleteGenre(ctx context.Context, id string) error {
+	const query = `DELETE FROM genre WHERE id = $1`
+
+	result, err := r.getQuerier(ctx).Exec(ctx, query, id)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == errForeignKeyViolation {
+		return entity.ErrGenreHasChildren
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return entity.ErrGenreNotFound
+	}
+
+	return nil
+}
+
+// ListGenres returns the subtree of rootID or the whole taxonomy, parents always precede their children.
+func (r *postgresImpl) ListGenres(ctx context.Context, rootID string) ([]entity.Genre, error) {
+	const query = `
+WITH RECURSIVE tree AS (
+    SELECT g.*, 0 AS depth
+    FROM genre g
+    WHERE ($1::uuid IS NULL AND g.parent_id IS NULL) OR g.id = $1::uuid
+    UNION ALL
+    SELECT g.*, t.depth + 1
+    FROM genre g
+    JOIN tree t ON g.parent_id = t.id
+)
+SELECT ` + genreColumns + `
+FROM tree
+ORDER BY depth, lower(name), id`
+
+	q := r.getQuerier(ctx)
+
+	if rootID != "" {
+		const queryExists = `SELECT EXISTS (SELECT 1 FROM genre WHERE id = $1)`
+
+		var exists bool
+		if err := q.QueryRow(ctx, queryExists, rootID).Scan(&exists); err != nil {
+			r.logger.Error("Error while accessing to data base.", zap.Error(err))
+			return nil, err
+		}
+		if !exists {
+			return nil, entity.ErrGenreNotFound
+		}
+	}
+
+	rows, err := q.Query(ctx, query, nullIfZero(rootID))
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	genres := make([]entity.Genre, 0)
+
+	for rows.Next() {
+		var genre entity.Genre
+		if err := rows.Scan(genreFields(&genre)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+
+	return genres, rows.Err()
+}
+
+func (r *postgresImpl) GetGenresInfo(ctx context.Context, ids []string) ([]entity.Genre, error) {
+	const query = `SELECT ` + genreColumns + ` FROM genre WHERE id = ANY($1)`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	genres := make([]entity.Genre, 0, len(ids))
+
+	for rows.Next() {
+		var genre entity.Genre
+		if err := rows.Scan(genreFields(&genre)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+
+	return genres, rows.Err()
+}
+
+func (r *postgresImpl) ListGenreBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error) {
+	const queryGenreExists = `SELECT EXISTS (SELECT 1 FROM genre WHERE id = $1)`
+
+	var exists bool
+	if err := r.getQuerier(ctx).QueryRow(ctx, queryGenreExists, params.Filter.GenreID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.ErrGenreNotFound
+	}
+
+	return r.ListBooks(ctx, params)
+}
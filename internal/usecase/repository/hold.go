@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ HoldRepository = (*postgresImpl)(nil)
+
+const holdColumns = `h.id, h.book_id, h.patron_id, h.pickup_branch_id, COALESCE(h.copy_id::text, ''), h.status,
+		h.ready_until, h.created_at, h.updated_at`
+
+func holdFields(hold *entity.Hold) []any {
+	return []any{
+		&hold.ID, &hold.BookID, &hold.PatronID, &hold.PickupBranchID, &hold.CopyID, &hold.Status, &hold.ReadyUntil,
+		&hold.CreatedAt, &hold.UpdatedAt,
+	}
+}
+
+// CreateHold puts the patron at the end of the queue of a book that is not deleted, the book is share-locked, so
+// a concurrent DeleteBook sees the hold.
+func (r *postgresImpl) CreateHold(ctx context.Context, bookID string, patronID string, pickupBranchID string) (entity.Hold, error) {
+	const query = `
+WITH h AS (
+    INSERT INTO hold (book_id, patron_id, pickup_branch_id)
+    SELECT id, $2, $3 FROM book WHERE id = $1 AND deleted_at IS NULL FOR SHARE
+    RETURNING *
+)
+SELECT ` + holdColumns + `, (SELECT count(*) FROM hold w WHERE w.book_id = h.book_id AND w.status = $4) + 1
+FROM h`
+
+	var hold entity.Hold
+	err := r.getQuerier(ctx).QueryRow(ctx, query, bookID, patronID, pickupBranchID, entity.HoldStatusWaiting).
+		Scan(append(holdFields(&hold), &hold.Position)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Hold{}, entity.ErrBookNotFound
+	}
+	if err != nil {
+		return entity.Hold{}, r.mapErr(err)
+	}
+
+	return hold, nil
+}
+
+// LockHold is expected to run in a transaction.
+func (r *postgresImpl) LockHold(ctx context.Context, id string) (entity.Hold, error) {
+	const query = `SELECT ` + holdColumns + ` FROM hold h WHERE h.id = $1 FOR UPDATE`
+
+	return r.lockHold(ctx, query, id)
+}
+
+// LockReadyHoldByCopy locks the hold the copy is held for, it is expected to run in a transaction.
+func (r *postgresImpl) LockReadyHoldByCopy(ctx context.Context, copyID string) (entity.Hold, error) {
+	const query = `SELECT ` + holdColumns + ` FROM hold h WHERE h.copy_id = $1 AND h.status = $2 FOR UPDATE`
+
+	return r.lockHold(ctx, query, copyID, entity.HoldStatusReady)
+}
+
+func (r *postgresImpl) lockHold(ctx context.Context, query string, args ...any) (entity.Hold, error) {
+	var hold entity.Hold
+	err := r.getQuerier(ctx).QueryRow(ctx, query, args...).Scan(holdFields(&hold)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Hold{}, entity.ErrHoldNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Hold{}, err
+	}
+
+	return hold, nil
+}
+
+// CancelHold cancels an active hold, the copy held for it becomes available unless it is already on the way to the
+// pickup branch.
+func (r *postgresImpl) CancelHold(ctx context.Context, id string) (entity.Hold, error) {
+	const query = `
+WITH h AS (
+    UPDATE hold SET status = $2 WHERE id = $1 AND status IN ($3, $4, $5) RETURNING *
+), c AS (
+    UPDATE copy SET status = $6 FROM h WHERE copy.id = h.copy_id AND copy.status = $7 RETURNING copy.id
+)
+SELECT ` + holdColumns + ` FROM h`
+
+	var hold entity.Hold
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, entity.HoldStatusCancelled, entity.HoldStatusWaiting,
+		entity.HoldStatusReady, entity.HoldStatusInTransit, entity.CopyStatusAvailable, entity.CopyStatusOnHold).
+		Scan(holdFields(&hold)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Hold{}, entity.ErrHoldNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Hold{}, err
+	}
+
+	return hold, nil
+}
+
+// ListHolds returns active holds in queue order, Position is set for waiting ones.
+func (r *postgresImpl) ListHolds(ctx context.Context, filter entity.HoldFilter) ([]entity.Hold, error) {
+	args := []any{entity.HoldStatusWaiting, entity.HoldStatusReady, entity.HoldStatusInTransit}
+	queueConditions := []string{"h.status IN ($1, $2, $3)"}
+	conditions := []string{"TRUE"}
+
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.BookID != "" {
+		queueConditions = append(queueConditions, "h.book_id = "+addArg(filter.BookID))
+	}
+	if filter.PatronID != "" {
+		patronID := addArg(filter.PatronID)
+		// Positions are counted over the whole queues of the patron's books.
+		queueConditions = append(queueConditions,
+			"h.book_id IN (SELECT book_id FROM hold WHERE patron_id = "+patronID+" AND status IN ($1, $2, $3))")
+		conditions = append(conditions, "h.patron_id = "+patronID)
+	}
+
+	query := `
+WITH queue AS (
+    SELECT h.*,
+           CASE
+               WHEN h.status = $1 THEN row_number() OVER (PARTITION BY h.book_id, h.status ORDER BY h.created_at, h.id)
+               ELSE 0 END AS position
+    FROM hold h
+    WHERE ` + strings.Join(queueConditions, " AND ") + `
+)
+SELECT ` + holdColumns + `, h.position
+FROM queue h
+WHERE ` + strings.Join(conditions, " AND ") + `
+ORDER BY h.book_id, h.status DESC, h.created_at, h.id`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holds := make([]entity.Hold, 0)
+
+	for rows.Next() {
+		var hold entity.Hold
+		if err := rows.Scan(append(holdFields(&hold), &hold.Position)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+// PromoteNextHold holds the copy for the first waiting patron of the book. When the copy is at the pickup branch the
+// hold is ready for the pickup days of the patron's tier, otherwise it is in transit until the copy is received there.
+func (r *postgresImpl) PromoteNextHold(ctx context.Context, bookID string, copyID string) (entity.Hold, error) {
+	const query = `
+WITH next AS (
+    SELECT h.id, h.pickup_branch_id = c.current_branch_id AS at_pickup, lp.hold_pickup_days
+    FROM hold h
+             JOIN patron p ON p.id = h.patron_id
+             JOIN loan_policy lp ON lp.tier = p.tier
+             JOIN copy c ON c.id = $2
+    WHERE h.book_id = $1 AND h.status = $3
+    ORDER BY h.created_at, h.id
+    LIMIT 1
+    FOR UPDATE OF h
+), h AS (
+    UPDATE hold
+    SET copy_id     = $2,
+        status      = CASE WHEN next.at_pickup THEN $4 ELSE $5 END,
+        ready_until = CASE WHEN next.at_pickup THEN now() + make_interval(days => next.hold_pickup_days) END
+    FROM next
+    WHERE hold.id = next.id
+    RETURNING hold.*
+), c AS (
+    UPDATE copy SET status = $6 FROM h WHERE copy.id = h.copy_id RETURNING copy.id
+)
+SELECT ` + holdColumns + ` FROM h`
+
+	var hold entity.Hold
+	err := r.getQuerier(ctx).QueryRow(ctx, query, bookID, copyID, entity.HoldStatusWaiting, entity.HoldStatusReady,
+		entity.HoldStatusInTransit, entity.CopyStatusOnHold).Scan(holdFields(&hold)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Hold{}, entity.ErrHoldNotFound
+	}
+	if err != nil {
+		return entity.Hold{}, r.mapErr(err)
+	}
+
+	return hold, nil
+}
+
+// ArriveHold makes the hold the received copy was sent for ready for pickup and holds the copy at the branch.
+func (r *postgresImpl) ArriveHold(ctx context.Context, copyID string) (entity.Hold, error) {
+	const query = `
+WITH h AS (
+    UPDATE hold
+    SET status = $3, ready_until = now() + make_interval(days => lp.hold_pickup_days)
+    FROM patron p
+             JOIN loan_policy lp ON lp.tier = p.tier
+    WHERE hold.copy_id = $1 AND hold.status = $2 AND p.id = hold.patron_id
+    RETURNING hold.*
+), c AS (
+    UPDATE copy SET status = $4 FROM h WHERE copy.id = h.copy_id RETURNING copy.id
+)
+SELECT ` + holdColumns + ` FROM h`
+
+	var hold entity.Hold
+	err := r.getQuerier(ctx).QueryRow(ctx, query, copyID, entity.HoldStatusInTransit, entity.HoldStatusReady,
+		entity.CopyStatusOnHold).Scan(holdFields(&hold)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Hold{}, entity.ErrHoldNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Hold{}, err
+	}
+
+	return hold, nil
+}
+
+// ExpireHolds expires holds not picked up in time and makes their copies available.
+func (r *postgresImpl) ExpireHolds(ctx context.Context) ([]entity.Hold, error) {
+	const query = `
+WITH expired AS (
+    SELECT id FROM hold WHERE status = $1 AND ready_until < now() FOR UPDATE SKIP LOCKED
+), h AS (
+    UPDATE hold SET status = $2 FROM expired WHERE hold.id = expired.id RETURNING hold.*
+), c AS (
+    UPDATE copy SET status = $3 FROM h WHERE copy.id = h.copy_id RETURNING copy.id
+)
+SELECT ` + holdColumns + ` FROM h`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, entity.HoldStatusReady, entity.HoldStatusExpired,
+		entity.CopyStatusAvailable)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holds := make([]entity.Hold, 0)
+
+	for rows.Next() {
+		var hold entity.Hold
+		if err := rows.Scan(holdFields(&hold)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+// FulfillHolds closes the active hold of the patron on the book once they borrow a copy of it. When another copy
+// was held for the patron and has not been shipped yet, that copy becomes available and its ID is returned.
+func (r *postgresImpl) FulfillHolds(ctx context.Context, patronID string, bookID string, copyID string) (string, error) {
+	const query = `
+WITH active AS (
+    SELECT id, copy_id FROM hold WHERE patron_id = $1 AND book_id = $2 AND status IN ($4, $5, $6) FOR UPDATE
+), h AS (
+    UPDATE hold SET status = $7, copy_id = $3 FROM active WHERE hold.id = active.id RETURNING active.copy_id
+), c AS (
+    UPDATE copy SET status = $8 FROM h WHERE copy.id = h.copy_id AND copy.id <> $3 AND copy.status = $9 RETURNING copy.id
+)
+SELECT COALESCE((SELECT id::text FROM c), '')`
+
+	var released string
+	err := r.getQuerier(ctx).QueryRow(ctx, query, patronID, bookID, copyID, entity.HoldStatusWaiting,
+		entity.HoldStatusReady, entity.HoldStatusInTransit, entity.HoldStatusFulfilled, entity.CopyStatusAvailable,
+		entity.CopyStatusOnHold).Scan(&released)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return "", err
+	}
+
+	return released, nil
+}
@@ -1,6 +1,6 @@
 package repository
 
-//go:generate ../../../bin/mockgen --build_flags=--mod=mod -destination=../../../generated/mocks/repository_mock.go -package=mocks . AuthorRepository,BooksRepository,Transactor,OutboxRepository
+//go:generate ../../../bin/mockgen --build_flags=--mod=mod -destination=../../../generated/mocks/repository_mock.go -package=mocks . AuthorRepository,BooksRepository,PublisherRepository,GenreRepository,SeriesRepository,WorkRepository,CopyRepository,PatronRepository,LoanRepository,HoldRepository,FineRepository,BranchRepository,TransferRepository,ReviewRepository,Transactor,OutboxRepository,ChangeLogRepository
 
 import (
 	"context"
@@ -12,15 +12,138 @@ import (
 type (
 	AuthorRepository interface {
 		RegisterAuthor(ctx context.Context, author entity.Author) (entity.Author, error)
-		ChangeAuthorInfo(ctx context.Context, id string, name string) (entity.Author, error)
+		ChangeAuthorInfo(ctx context.Context, update entity.AuthorUpdate) (entity.Author, error)
 		GetAuthorInfo(ctx context.Context, id string) (entity.Author, error)
-		GetAuthorBooks(ctx context.Context, id string) ([]entity.Book, error)
+		GetAuthorsInfo(ctx context.Context, ids []string) (map[string]entity.Author, error)
+		ListAuthors(ctx context.Context, params entity.ListAuthorsParams) ([]entity.Author, error)
+		GetAuthorBooks(ctx context.Context, params entity.ListBooksParams, handle func(entity.Book) error) error
+		DeleteAuthor(ctx context.Context, id string) (entity.Author, error)
+		RestoreAuthor(ctx context.Context, id string) (entity.Author, error)
+		PurgeAuthors(ctx context.Context, retention time.Duration) (int64, error)
+		FindDuplicateAuthors(ctx context.Context, params entity.FindDuplicateAuthorsParams) ([]entity.DuplicateAuthors, error)
+		MergeAuthors(ctx context.Context, sourceIDs []string, targetID string) (entity.AuthorMerge, error)
 	}
 
 	BooksRepository interface {
 		AddBook(ctx context.Context, book entity.Book) (entity.Book, error)
-		UpdateBook(ctx context.Context, id string, name string, authorIDs []string) (entity.Book, error)
+		AddBooks(ctx context.Context, books []entity.Book) ([]entity.Book, error)
+		UpdateBook(ctx context.Context, update entity.BookUpdate) (entity.Book, error)
 		GetBookInfo(ctx context.Context, id string) (entity.Book, error)
+		GetBookByISBN(ctx context.Context, isbn string) (entity.Book, error)
+		GetBooksInfo(ctx context.Context, ids []string) ([]entity.Book, error)
+		ListBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error)
+		SearchCatalog(ctx context.Context, params entity.SearchParams) ([]entity.SearchResult, error)
+		DeleteBook(ctx context.Context, id string) (entity.Book, error)
+		RestoreBook(ctx context.Context, id string) (entity.Book, error)
+		PurgeBooks(ctx context.Context, retention time.Duration) (int64, error)
+	}
+
+	PublisherRepository interface {
+		RegisterPublisher(ctx context.Context, publisher entity.Publisher) (entity.Publisher, error)
+		GetPublisherInfo(ctx context.Context, id string) (entity.Publisher, error)
+		GetPublishersInfo(ctx context.Context, ids []string) ([]entity.Publisher, error)
+		ListPublisherBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error)
+	}
+
+	GenreRepository interface {
+		CreateGenre(ctx context.Context, genre entity.Genre) (entity.Genre, error)
+		UpdateGenre(ctx context.Context, update entity.GenreUpdate) (entity.Genre, error)
+		DeleteGenre(ctx context.Context, id string) error
+		ListGenres(ctx context.Context, rootID string) ([]entity.Genre, error)
+		GetGenresInfo(ctx context.Context, ids []string) ([]entity.Genre, error)
+		ListGenreBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error)
+	}
+
+	SeriesRepository interface {
+		CreateSeries(ctx context.Context, series entity.Series) (entity.Series, error)
+		GetSeries(ctx context.Context, id string) (entity.Series, error)
+		LockSeries(ctx context.Context, ids []string) error
+		GetBookSeries(ctx context.Context, bookID string) (entity.SeriesVolume, error)
+		SetBookSeries(ctx context.Context, volume entity.SeriesVolume) error
+		SetSeriesVolumes(ctx context.Context, seriesID string, volumes []entity.SeriesVolume) error
+		RemoveBookFromSeries(ctx context.Context, bookID string) error
+	}
+
+	WorkRepository interface {
+		GetWork(ctx context.Context, id string) (entity.Work, error)
+		GetWorksInfo(ctx context.Context, ids []string) ([]entity.Work, error)
+	}
+
+	CopyRepository interface {
+		AddCopy(ctx context.Context, bookCopy entity.Copy) (entity.Copy, error)
+		GetCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error)
+		SetCopyStatus(ctx context.Context, id string, status entity.CopyStatus) (entity.Copy, error)
+		RetireCopy(ctx context.Context, id string) (entity.Copy, error)
+		GetBranchCopyCounts(ctx context.Context, bookID string) ([]entity.BranchCopyCounts, error)
+		LockCopy(ctx context.Context, id string) (entity.Copy, error)
+		LockCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error)
+		LockAvailableCopy(ctx context.Context, bookID string) (entity.Copy, error)
+	}
+
+	PatronRepository interface {
+		RegisterPatron(ctx context.Context, patron entity.Patron, months int) (entity.Patron, error)
+		UpdatePatron(ctx context.Context, update entity.PatronUpdate) (entity.Patron, error)
+		GetPatron(ctx context.Context, id string) (entity.Patron, error)
+		LockPatron(ctx context.Context, id string) (entity.Patron, error)
+		RenewMembership(ctx context.Context, id string, months int) (entity.Patron, error)
+		BlockPatron(ctx context.Context, id string, blocked bool, reason string) (entity.Patron, error)
+	}
+
+	LoanRepository interface {
+		GetLoanPolicy(ctx context.Context, tier entity.MembershipTier) (entity.LoanPolicy, error)
+		CountActiveLoans(ctx context.Context, patronID string) (int, error)
+		CreateLoan(ctx context.Context, copyID string, patronID string, branchID string, loanDays int) (entity.Loan, error)
+		LockLoan(ctx context.Context, id string) (entity.Loan, error)
+		LockActiveLoanByBarcode(ctx context.Context, barcode string) (entity.Loan, error)
+		RenewLoan(ctx context.Context, id string, loanDays int) (entity.Loan, error)
+		CloseLoan(ctx context.Context, id string, branchID string) (entity.Loan, error)
+	}
+
+	HoldRepository interface {
+		CreateHold(ctx context.Context, bookID string, patronID string, pickupBranchID string) (entity.Hold, error)
+		LockHold(ctx context.Context, id string) (entity.Hold, error)
+		LockReadyHoldByCopy(ctx context.Context, copyID string) (entity.Hold, error)
+		CancelHold(ctx context.Context, id string) (entity.Hold, error)
+		ListHolds(ctx context.Context, filter entity.HoldFilter) ([]entity.Hold, error)
+		PromoteNextHold(ctx context.Context, bookID string, copyID string) (entity.Hold, error)
+		ArriveHold(ctx context.Context, copyID string) (entity.Hold, error)
+		ExpireHolds(ctx context.Context) ([]entity.Hold, error)
+		FulfillHolds(ctx context.Context, patronID string, bookID string, copyID string) (string, error)
+	}
+
+	FineRepository interface {
+		GetFinePolicy(ctx context.Context, tier entity.MembershipTier) (entity.FinePolicy, error)
+		AssessLoanFine(ctx context.Context, loanID string) (entity.Fine, error)
+		GetLoanFine(ctx context.Context, loanID string) (entity.Fine, error)
+		AssessOverdueFines(ctx context.Context) (int64, error)
+		GetFineBalance(ctx context.Context, patronID string) (int64, error)
+		ListFines(ctx context.Context, patronID string) ([]entity.Fine, error)
+		LockFine(ctx context.Context, id string) (entity.Fine, error)
+		PayFine(ctx context.Context, id string, amountCents int64) (entity.Fine, error)
+		WaiveFine(ctx context.Context, id string, reason string) (entity.Fine, error)
+	}
+
+	BranchRepository interface {
+		CreateBranch(ctx context.Context, branch entity.Branch) (entity.Branch, error)
+		ListBranches(ctx context.Context) ([]entity.Branch, error)
+	}
+
+	TransferRepository interface {
+		CreateTransfer(ctx context.Context, copyID string, toBranchID string) (entity.Transfer, error)
+		LockTransfer(ctx context.Context, id string) (entity.Transfer, error)
+		ShipTransfer(ctx context.Context, id string) (entity.Transfer, error)
+		ReceiveTransfer(ctx context.Context, id string) (entity.Transfer, error)
+		CancelPendingTransfer(ctx context.Context, copyID string) (bool, error)
+		ListTransfers(ctx context.Context, filter entity.TransferFilter) ([]entity.Transfer, error)
+	}
+
+	ReviewRepository interface {
+		CreateReview(ctx context.Context, review entity.Review) (entity.Review, error)
+		LockReview(ctx context.Context, id string) (entity.Review, error)
+		ModerateReview(ctx context.Context, id string, status entity.ReviewStatus, note string) (entity.Review, error)
+		ListReviews(ctx context.Context, params entity.ListReviewsParams) ([]entity.Review, error)
+		GetBookRating(ctx context.Context, bookID string) (entity.BookRating, error)
+		AdjustBookRating(ctx context.Context, bookID string, ratingDelta int64, countDelta int) error
 	}
 
 	Transactor interface {
@@ -29,10 +152,16 @@ type (
 
 	OutboxRepository interface {
 		SendMessage(ctx context.Context, idempotencyKey string, kind OutboxKind, message []byte) error
+		SendMessages(ctx context.Context, messages []OutboxData) error
 		GetMessages(ctx context.Context, batchSize int, inProgressTTL time.Duration) ([]OutboxData, error)
 		MarkAsProcessed(ctx context.Context, idempotencyKeys []string) error
 	}
 
+	ChangeLogRepository interface {
+		GetChanges(ctx context.Context, fromSequence int64, limit int) ([]entity.Change, error)
+		SubscribeChanges() (<-chan struct{}, func())
+	}
+
 	OutboxData struct {
 		IdempotencyKey string
 		Kind           OutboxKind
@@ -46,6 +175,11 @@ const (
 	OutboxKindUndefined OutboxKind = iota
 	OutboxKindBook
 	OutboxKindAuthor
+	OutboxKindBookDeleted
+	OutboxKindAuthorDeleted
+	OutboxKindPublisher
+	OutboxKindPatron
+	OutboxKindHoldReady
 )
 
 func (o OutboxKind) String() string {
@@ -54,6 +188,16 @@ func (o OutboxKind) String() string {
 		return "book"
 	case OutboxKindAuthor:
 		return "author"
+	case OutboxKindBookDeleted:
+		return "book_deleted"
+	case OutboxKindAuthorDeleted:
+		return "author_deleted"
+	case OutboxKindPublisher:
+		return "publisher"
+	case OutboxKindPatron:
+		return "patron"
+	case OutboxKindHoldReady:
+		return "hold_ready"
 	default:
 		return "undefined"
 	}
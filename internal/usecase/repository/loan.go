@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ LoanRepository = (*postgresImpl)(nil)
+
+const loanColumns = `l.id, l.copy_id, c.book_id, l.patron_id, COALESCE(l.branch_id::text, ''), l.checked_out_at,
+		l.due_at, l.returned_at, l.renewals`
+
+func loanFields(loan *entity.Loan) []any {
+	return []any{
+		&loan.ID, &loan.CopyID, &loan.BookID, &loan.PatronID, &loan.BranchID, &loan.CheckedOutAt, &loan.DueAt,
+		&loan.ReturnedAt, &loan.Renewals,
+	}
+}
+
+func (r *postgresImpl) GetLoanPolicy(ctx context.Context, tier entity.MembershipTier) (entity.LoanPolicy, error) {
+	const query = `SELECT tier, loan_days, max_loans, max_renewals FROM loan_policy WHERE tier = $1`
+
+	var policy entity.LoanPolicy
+	err := r.getQuerier(ctx).QueryRow(ctx, query, tier).
+		Scan(&policy.Tier, &policy.LoanDays, &policy.MaxLoans, &policy.MaxRenewals)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.LoanPolicy{}, entity.ErrLoanPolicyNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.LoanPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (r *postgresImpl) CountActiveLoans(ctx context.Context, patronID string) (int, error) {
+	const query = `SELECT count(*) FROM loan WHERE patron_id = $1 AND returned_at IS NULL`
+
+	var count int
+	if err := r.getQuerier(ctx).QueryRow(ctx, query, patronID).Scan(&count); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CreateLoan lends the copy for loanDays and marks it as on loan, an empty branchID leaves the pickup branch unset.
+func (r *postgresImpl) CreateLoan(ctx context.Context, copyID string, patronID string, branchID string, loanDays int) (entity.Loan, error) {
+	const query = `
+WITH l AS (
+    INSERT INTO loan (copy_id, patron_id, branch_id, due_at)
+    VALUES ($1, $2, $3, now() + make_interval(days => $4))
+    RETURNING *
+), c AS (
+    UPDATE copy SET status = $5 WHERE id = $1 RETURNING book_id
+)
+SELECT ` + loanColumns + ` FROM l, c`
+
+	var loan entity.Loan
+	err := r.getQuerier(ctx).QueryRow(ctx, query, copyID, patronID, nullIfZero(branchID), loanDays,
+		entity.CopyStatusOnLoan).Scan(loanFields(&loan)...)
+	if err != nil {
+		return entity.Loan{}, r.mapErr(err)
+	}
+
+	return loan, nil
+}
+
+// LockLoan and LockActiveLoanByBarcode are expected to run in a transaction.
+func (r *postgresImpl) LockLoan(ctx context.Context, id string) (entity.Loan, error) {
+	const query = `
+SELECT ` + loanColumns + `
+FROM loan l
+         JOIN copy c ON c.id = l.copy_id
+WHERE l.id = $1
+FOR UPDATE OF l`
+
+	return r.lockLoan(ctx, query, id)
+}
+
+func (r *postgresImpl) LockActiveLoanByBarcode(ctx context.Context, barcode string) (entity.Loan, error) {
+	const query = `
+SELECT ` + loanColumns + `
+FROM loan l
+         JOIN copy c ON c.id = l.copy_id
+WHERE c.barcode = $1 AND l.returned_at IS NULL
+FOR UPDATE OF l`
+
+	return r.lockLoan(ctx, query, barcode)
+}
+
+func (r *postgresImpl) lockLoan(ctx context.Context, query string, arg string) (entity.Loan, error) {
+	var loan entity.Loan
+	err := r.getQuerier(ctx).QueryRow(ctx, query, arg).Scan(loanFields(&loan)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Loan{}, entity.ErrLoanNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+// RenewLoan moves the due date to loanDays from now.
+func (r *postgresImpl) RenewLoan(ctx context.Context, id string, loanDays int) (entity.Loan, error) {
+	const query = `
+UPDATE loan l
+SET due_at = now() + make_interval(days => $2), renewals = l.renewals + 1
+FROM copy c
+WHERE l.id = $1 AND l.returned_at IS NULL AND c.id = l.copy_id
+RETURNING ` + loanColumns
+
+	var loan entity.Loan
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, loanDays).Scan(loanFields(&loan)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Loan{}, entity.ErrLoanNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+// CloseLoan returns the loan and makes the copy available again, a copy returned to another branch stays there.
+func (r *postgresImpl) CloseLoan(ctx context.Context, id string, branchID string) (entity.Loan, error) {
+	const query = `
+WITH l AS (
+    UPDATE loan SET returned_at = now() WHERE id = $1 AND returned_at IS NULL RETURNING *
+), c AS (
+    UPDATE copy SET status = $2, current_branch_id = COALESCE($3, copy.current_branch_id)
+    FROM l
+    WHERE copy.id = l.copy_id
+    RETURNING copy.book_id
+)
+SELECT ` + loanColumns + ` FROM l, c`
+
+	var loan entity.Loan
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, entity.CopyStatusAvailable, nullIfZero(branchID)).
+		Scan(loanFields(&loan)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Loan{}, entity.ErrLoanNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
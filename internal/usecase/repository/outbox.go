@@ -41,6 +41,41 @@ ON CONFLICT (idempotency_key) DO NOTHING`
 	return nil
 }
 
+func (o *outboxRepository) SendMessages(ctx context.Context, messages []OutboxData) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	const query = `
+INSERT INTO outbox (idempotency_key, data, status, kind)
+SELECT m.idempotency_key, m.data::jsonb, 'CREATED', m.kind
+FROM unnest($1::text[], $2::text[], $3::int[]) AS m(idempotency_key, data, kind)
+ON CONFLICT (idempotency_key) DO NOTHING`
+
+	keys := make([]string, len(messages))
+	data := make([]string, len(messages))
+	kinds := make([]OutboxKind, len(messages))
+
+	for i, message := range messages {
+		keys[i] = message.IdempotencyKey
+		data[i] = string(message.RawData)
+		kinds[i] = message.Kind
+	}
+
+	var err error
+	if tx, txErr := extractTx(ctx); txErr == nil {
+		_, err = tx.Exec(ctx, query, keys, data, kinds)
+	} else {
+		_, err = o.db.Exec(ctx, query, keys, data, kinds)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (o *outboxRepository) GetMessages(ctx context.Context, batchSize int, inProgressTTL time.Duration) ([]OutboxData, error) {
 	const query = `
 UPDATE outbox
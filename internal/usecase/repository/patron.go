@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ PatronRepository = (*postgresImpl)(nil)
+
+const patronColumns = `id, name, COALESCE(email, ''), COALESCE(phone, ''), card_number, tier, expires_on, blocked,
+		COALESCE(block_reason, ''), created_at, updated_at, version`
+
+func patronFields(patron *entity.Patron) []any {
+	return []any{
+		&patron.ID, &patron.Name, &patron.Email, &patron.Phone, &patron.CardNumber, &patron.Tier, &patron.ExpiresOn,
+		&patron.Blocked, &patron.BlockReason, &patron.CreatedAt, &patron.UpdatedAt, &patron.Version,
+	}
+}
+
+// RegisterPatron starts a membership of the given number of months from today.
+func (r *postgresImpl) RegisterPatron(ctx context.Context, patron entity.Patron, months int) (entity.Patron, error) {
+	const query = `
+INSERT INTO patron (name, email, phone, card_number, tier, expires_on)
+VALUES ($1, $2, $3, $4, $5, (CURRENT_DATE + make_interval(months => $6))::date)
+RETURNING ` + patronColumns
+
+	var result entity.Patron
+	err := r.getQuerier(ctx).
+		QueryRow(ctx, query, patron.Name, nullIfZero(patron.Email), nullIfZero(patron.Phone), patron.CardNumber,
+			patron.Tier, months).
+		Scan(patronFields(&result)...)
+	if err != nil {
+		return entity.Patron{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) UpdatePatron(ctx context.Context, update entity.PatronUpdate) (entity.Patron, error) {
+	args := []any{update.ID, update.ExpectedVersion}
+	sets := make([]string, 0)
+
+	addSet := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
+	}
+
+	if update.Name != nil {
+		addSet("name", *update.Name)
+	}
+	if update.Email != nil {
+		addSet("email", nullIfZero(*update.Email))
+	}
+	if update.Phone != nil {
+		addSet("phone", nullIfZero(*update.Phone))
+	}
+	if update.Tier != nil {
+		addSet("tier", *update.Tier)
+	}
+	if len(sets) == 0 {
+		sets = append(sets, "version = version")
+	}
+
+	query := `
+UPDATE patron
+SET ` + strings.Join(sets, ", ") + `
+WHERE id = $1 AND ($2::bigint = 0 OR version = $2::bigint)
+RETURNING ` + patronColumns
+
+	q := r.getQuerier(ctx)
+
+	var patron entity.Patron
+	err := q.QueryRow(ctx, query, args...).Scan(patronFields(&patron)...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entity.Patron{}, r.mapErr(err)
+	}
+	if err == nil {
+		return patron, nil
+	}
+
+	const queryExists = `SELECT EXISTS (SELECT 1 FROM patron WHERE id = $1)`
+
+	var exists bool
+	if err := q.QueryRow(ctx, queryExists, update.ID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Patron{}, err
+	}
+	if exists {
+		return entity.Patron{}, entity.ErrVersionMismatch
+	}
+
+	return entity.Patron{}, entity.ErrPatronNotFound
+}
+
+func (r *postgresImpl) GetPatron(ctx context.Context, id string) (entity.Patron, error) {
+	const query = `SELECT ` + patronColumns + ` FROM patron WHERE id = $1`
+
+	var patron entity.Patron
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(patronFields(&patron)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Patron{}, entity.ErrPatronNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Patron{}, err
+	}
+
+	return patron, nil
+}
+
+// LockPatron is GetPatron for a transaction that decides on the patron's loans.
+func (r *postgresImpl) LockPatron(ctx context.Context, id string) (entity.Patron, error) {
+	const query = `SELECT ` + patronColumns + ` FROM patron WHERE id = $1 FOR UPDATE`
+
+	var patron entity.Patron
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(patronFields(&patron)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Patron{}, entity.ErrPatronNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Patron{}, err
+	}
+
+	return patron, nil
+}
+
+// RenewMembership extends the membership from its expiry date, or from today when it has already expired.
+func (r *postgresImpl) RenewMembership(ctx context.Context, id string, months int) (entity.Patron, error) {
+	const query = `
+UPDATE patron
+SET expires_on = (GREATEST(expires_on, CURRENT_DATE) + make_interval(months => $2))::date
+WHERE id = $1
+RETURNING ` + patronColumns
+
+	var patron entity.Patron
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, months).Scan(patronFields(&patron)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Patron{}, entity.ErrPatronNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Patron{}, err
+	}
+
+	return patron, nil
+}
+
+// BlockPatron keeps the reason only while the patron is blocked.
+func (r *postgresImpl) BlockPatron(ctx context.Context, id string, blocked bool, reason string) (entity.Patron, error) {
+	const query = `
+UPDATE patron
+SET blocked = $2, block_reason = CASE WHEN $2 THEN $3::text END
+WHERE id = $1
+RETURNING ` + patronColumns
+
+	var patron entity.Patron
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, blocked, nullIfZero(reason)).Scan(patronFields(&patron)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Patron{}, entity.ErrPatronNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Patron{}, err
+	}
+
+	return patron, nil
+}
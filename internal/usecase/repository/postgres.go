@@ -4,7 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -27,22 +32,168 @@ func NewPostgresRepository(logger *zap.Logger, db *pgxpool.Pool) *postgresImpl {
 	}
 }
 
-func (r *postgresImpl) getRows(bookID string, authorIDs []string) [][]any {
-	rows := make([][]any, len(authorIDs))
-	for i := range rows {
-		rows[i] = []any{&authorIDs[i], &bookID}
+// bookColumns are scanned by bookFields, the optional metadata is read as zero values.
+const bookColumns = `b.id, b.name, b.created_at, b.updated_at, b.version,
+		COALESCE(b.isbn, ''), COALESCE(b.publication_year, 0), COALESCE(b.language, ''),
+		COALESCE(b.page_count, 0), COALESCE(b.description, ''), COALESCE(b.subtitle, ''),
+		COALESCE(b.publisher_id::text, ''),
+		ARRAY(SELECT bg.genre_id::text FROM book_genre bg WHERE bg.book_id = b.id ORDER BY bg.genre_id), b.work_id`
+
+// selectContributors aggregates the contributors of the work given after it into contributorRows.
+const selectContributors = `
+		SELECT COALESCE(jsonb_agg(jsonb_build_object('author_id', aw.author_id, 'role', aw.role, 'position', aw.position)
+		                          ORDER BY aw.position, aw.author_id), '[]')
+		FROM author_work aw
+		JOIN author a on a.id = aw.author_id
+		WHERE a.deleted_at IS NULL AND aw.work_id = `
+
+const bookContributors = `(` + selectContributors + `b.work_id)`
+
+const selectBooks = `
+		SELECT ` + bookColumns + `, ` + bookContributors + `
+		FROM book b
+		`
+
+const queryBookInfo = selectBooks + `WHERE b.id = $1 AND b.deleted_at IS NULL`
+
+func bookFields(book *entity.Book) []any {
+	return []any{
+		&book.ID, &book.Name, &book.CreatedAt, &book.UpdatedAt, &book.Version,
+		&book.ISBN, &book.PublicationYear, &book.Language, &book.PageCount, &book.Description, &book.Subtitle,
+		&book.PublisherID, &book.GenreIDs, &book.WorkID,
+	}
+}
+
+// nullIfZero stores an unset optional column as NULL.
+func nullIfZero[T comparable](value T) any {
+	var zero T
+	if value == zero {
+		return nil
+	}
+
+	return value
+}
+
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+func (r *postgresImpl) getQuerier(ctx context.Context) querier {
+	if tx, err := extractTx(ctx); err == nil {
+		return tx
+	}
+
+	return r.db
+}
+
+// contributorRow is a contributor as aggregated by selectContributors.
+type contributorRow struct {
+	AuthorID string                 `json:"author_id"`
+	Role     entity.ContributorRole `json:"role"`
+	Position int                    `json:"position"`
+}
+
+// setContributors fills both the contributors and the author ids of the book.
+func setContributors(book *entity.Book, rows []contributorRow) {
+	book.Contributors, book.AuthorIDs = nil, nil
+
+	for _, row := range rows {
+		book.Contributors = append(book.Contributors, entity.Contributor(row))
+		book.AuthorIDs = append(book.AuthorIDs, row.AuthorID)
+	}
+}
+
+// workContributors returns the contributors to store for the book, plain author ids are authors in the given order.
+func workContributors(book entity.Book) []entity.Contributor {
+	if book.Contributors != nil {
+		return book.Contributors
+	}
+
+	contributors := make([]entity.Contributor, len(book.AuthorIDs))
+	for i, authorID := range book.AuthorIDs {
+		contributors[i] = entity.Contributor{AuthorID: authorID, Role: entity.ContributorRoleAuthor}
+	}
+
+	return contributors
+}
+
+var authorWorkColumns = []string{"author_id", "work_id", "role", "position"}
+
+func (r *postgresImpl) getRows(workID string, contributors []entity.Contributor) [][]any {
+	rows := make([][]any, len(contributors))
+	for i, contributor := range contributors {
+		rows[i] = []any{contributor.AuthorID, workID, contributor.Role, i + 1}
 	}
 	return rows
 }
 
-func (r *postgresImpl) mapErr(err error) error {
-	const ErrForeignKeyViolation = "23503"
+// resolvedAuthorID is the author id passed as the placeholder, with the id of a merged author replaced by the author
+// it was merged into.
+func resolvedAuthorID(placeholder string) string {
+	return fmt.Sprintf("COALESCE((SELECT author_id FROM author_redirect WHERE id = %[1]s::uuid), %[1]s::uuid)", placeholder)
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
 
+const (
+	errForeignKeyViolation = "23503"
+	errUniqueViolation     = "23505"
+	errCheckViolation      = "23514"
+)
+
+// constraintErrors maps violated constraints to entity errors, other foreign keys refer to authors.
+var constraintErrors = map[string]error{
+	"book_publisher_id_fkey":        entity.ErrPublisherNotFound,
+	"book_rating_book_id_fkey":      entity.ErrBookNotFound,
+	"copy_current_branch_id_fkey":   entity.ErrBranchNotFound,
+	"copy_home_branch_id_fkey":      entity.ErrBranchNotFound,
+	"book_work_id_fkey":             entity.ErrWorkNotFound,
+	"index_copy_barcode":            entity.ErrCopyBarcodeExists,
+	"author_life_dates_check":       entity.ErrAuthorLifeDates,
+	"book_genre_genre_id_fkey":      entity.ErrGenreNotFound,
+	"fine_paid_check":               entity.ErrFineOverpaid,
+	"genre_parent_id_fkey":          entity.ErrGenreNotFound,
+	"hold_pickup_branch_id_fkey":    entity.ErrBranchNotFound,
+	"index_book_isbn":               entity.ErrBookISBNExists,
+	"index_branch_name":             entity.ErrBranchExists,
+	"index_genre_parent_name":       entity.ErrGenreExists,
+	"index_hold_active_patron_book": entity.ErrHoldExists,
+	"hold_patron_id_fkey":           entity.ErrPatronNotFound,
+	"index_loan_active_copy":        entity.ErrCopyNotAvailable,
+	"index_transfer_active_copy":    entity.ErrTransferExists,
+	"index_patron_card_number":      entity.ErrPatronCardExists,
+	"index_review_book_patron":      entity.ErrReviewExists,
+	"loan_branch_id_fkey":           entity.ErrBranchNotFound,
+	"loan_copy_id_fkey":             entity.ErrCopyNotFound,
+	"loan_patron_id_fkey":           entity.ErrPatronNotFound,
+	"patron_contact_check":          entity.ErrPatronContactRequired,
+	"review_book_id_fkey":           entity.ErrBookNotFound,
+	"review_patron_id_fkey":         entity.ErrPatronNotFound,
+	"series_book_book_id_fkey":      entity.ErrBookNotFound,
+	"series_book_series_id_fkey":    entity.ErrSeriesNotFound,
+	"series_book_volume_key":        entity.ErrSeriesVolumeTaken,
+	"transfer_branches_check":       entity.ErrTransferSameBranch,
+	"transfer_to_branch_id_fkey":    entity.ErrBranchNotFound,
+}
+
+func (r *postgresImpl) mapErr(err error) error {
 	if err != nil {
 		var pgErr *pgconn.PgError
 
-		if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
-			return entity.ErrAuthorNotFound
+		if errors.As(err, &pgErr) && (pgErr.Code == errForeignKeyViolation || pgErr.Code == errUniqueViolation ||
+			pgErr.Code == errCheckViolation) {
+			if mapped, ok := constraintErrors[pgErr.ConstraintName]; ok {
+				return mapped
+			}
+
+			if pgErr.Code == errForeignKeyViolation {
+				return entity.ErrAuthorNotFound
+			}
 		}
 	}
 
@@ -50,12 +201,12 @@ func (r *postgresImpl) mapErr(err error) error {
 	return err
 }
 
-func (r *postgresImpl) addBookAuthors(ctx context.Context, tx pgx.Tx, book entity.Book) error {
+func (r *postgresImpl) addWorkAuthors(ctx context.Context, tx pgx.Tx, workID string, contributors []entity.Contributor) error {
 	_, err := tx.CopyFrom(
 		ctx,
-		pgx.Identifier{"author_book"},
-		[]string{"author_id", "book_id"},
-		pgx.CopyFromRows(r.getRows(book.ID, book.AuthorIDs)),
+		pgx.Identifier{"author_work"},
+		authorWorkColumns,
+		pgx.CopyFromRows(r.getRows(workID, contributors)),
 	)
 
 	if err != nil {
@@ -65,20 +216,33 @@ func (r *postgresImpl) addBookAuthors(ctx context.Context, tx pgx.Tx, book entit
 	return nil
 }
 
+func (r *postgresImpl) addBookGenres(ctx context.Context, tx pgx.Tx, book entity.Book) error {
+	rows := make([][]any, len(book.GenreIDs))
+	for i, genreID := range book.GenreIDs {
+		rows[i] = []any{book.ID, genreID}
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"book_genre"}, []string{"book_id", "genre_id"}, pgx.CopyFromRows(rows))
+	if err != nil {
+		return r.mapErr(err)
+	}
+
+	return nil
+}
+
 func (r *postgresImpl) getBookFromRows(row pgx.Row) (entity.Book, error) {
-	var book entity.Book
-	bookAuthors := make([]*string, 0)
-	err := row.Scan(&book.ID, &book.Name, &book.CreatedAt, &book.UpdatedAt, &bookAuthors)
+	var (
+		book         entity.Book
+		contributors []contributorRow
+	)
+
+	err := row.Scan(append(bookFields(&book), &contributors)...)
 	if err != nil {
 		r.logger.Error("Error while accessing to data base.", zap.Error(err))
 		return entity.Book{}, err
 	}
 
-	for _, author := range bookAuthors {
-		if author != nil {
-			book.AuthorIDs = append(book.AuthorIDs, *author)
-		}
-	}
+	setContributors(&book, contributors)
 
 	return book, nil
 }
@@ -117,15 +281,35 @@ func (r *postgresImpl) AddBook(ctx context.Context, book entity.Book) (resultBoo
 		}()
 	}
 
-	const queryBook = `INSERT INTO book (name) VALUES ($1) RETURNING id, created_at, updated_at`
-	err = tx.QueryRow(ctx, queryBook, book.Name).Scan(&book.ID, &book.CreatedAt, &book.UpdatedAt)
+	newWork := book.WorkID == ""
+	if newWork {
+		book.WorkID, err = r.addWork(ctx, tx, book.Name, workContributors(book))
+		if err != nil {
+			return entity.Book{}, err
+		}
+	}
+
+	const queryBook = `
+INSERT INTO book (name, isbn, publication_year, language, page_count, description, subtitle, publisher_id, work_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, created_at, updated_at, version`
+	err = tx.QueryRow(ctx, queryBook, book.Name, nullIfZero(book.ISBN), nullIfZero(book.PublicationYear),
+		nullIfZero(book.Language), nullIfZero(book.PageCount), nullIfZero(book.Description), nullIfZero(book.Subtitle),
+		nullIfZero(book.PublisherID), book.WorkID).
+		Scan(&book.ID, &book.CreatedAt, &book.UpdatedAt, &book.Version)
 
 	if err != nil {
-		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, r.mapErr(err)
+	}
+
+	contributors, err := r.getWorkContributors(ctx, tx, book.WorkID)
+	if err != nil {
 		return entity.Book{}, err
 	}
 
-	err = r.addBookAuthors(ctx, tx, book)
+	setContributors(&book, contributors)
+
+	err = r.addBookGenres(ctx, tx, book)
 	if err != nil {
 		return entity.Book{}, err
 	}
@@ -133,7 +317,107 @@ func (r *postgresImpl) AddBook(ctx context.Context, book entity.Book) (resultBoo
 	return book, nil
 }
 
-func (r *postgresImpl) UpdateBook(ctx context.Context, id string, name string, authorIDs []string) (entity.Book, error) {
+func (r *postgresImpl) AddBooks(ctx context.Context, books []entity.Book) ([]entity.Book, error) {
+	tx, err := extractTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.Book, len(books))
+	ids := make([]string, len(books))
+	bookRows := make([][]any, len(books))
+	workRows := make([][]any, 0, len(books))
+	authorRows := make([][]any, 0, len(books))
+	genreRows := make([][]any, 0)
+
+	for i, book := range books {
+		book.ID = uuid.NewString()
+
+		if book.WorkID == "" {
+			book.WorkID = uuid.NewString()
+			workRows = append(workRows, []any{book.WorkID, book.Name})
+
+			authorRows = append(authorRows, r.getRows(book.WorkID, workContributors(book))...)
+		}
+
+		result[i] = book
+		ids[i] = book.ID
+		bookRows[i] = []any{
+			book.ID, book.Name, nullIfZero(book.ISBN), nullIfZero(book.PublicationYear), nullIfZero(book.Language),
+			nullIfZero(book.PageCount), nullIfZero(book.Description), nullIfZero(book.Subtitle), nullIfZero(book.PublisherID),
+			book.WorkID,
+		}
+
+		for _, genreID := range book.GenreIDs {
+			genreRows = append(genreRows, []any{book.ID, genreID})
+		}
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"work"}, []string{"id", "name"}, pgx.CopyFromRows(workRows))
+	if err != nil {
+		return nil, r.mapErr(err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"author_work"}, authorWorkColumns, pgx.CopyFromRows(authorRows))
+	if err != nil {
+		return nil, r.mapErr(err)
+	}
+
+	columns := []string{
+		"id", "name", "isbn", "publication_year", "language", "page_count", "description", "subtitle", "publisher_id",
+		"work_id",
+	}
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"book"}, columns, pgx.CopyFromRows(bookRows))
+	if err != nil {
+		return nil, r.mapErr(err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"book_genre"}, []string{"book_id", "genre_id"}, pgx.CopyFromRows(genreRows))
+	if err != nil {
+		return nil, r.mapErr(err)
+	}
+
+	// Editions of existing works get the authors of their work.
+	const queryTimestamps = `
+SELECT b.id, b.created_at, b.updated_at, b.version, ` + bookContributors + `
+FROM book b
+WHERE b.id = ANY($1)`
+	rows, err := tx.Query(ctx, queryTimestamps, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	positions := make(map[string]int, len(result))
+	for i, book := range result {
+		positions[book.ID] = i
+	}
+
+	for rows.Next() {
+		var (
+			id                   string
+			createdAt, updatedAt time.Time
+			version              int64
+			contributors         []contributorRow
+		)
+
+		if err := rows.Scan(&id, &createdAt, &updatedAt, &version, &contributors); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+
+		result[positions[id]].CreatedAt = createdAt
+		result[positions[id]].UpdatedAt = updatedAt
+		result[positions[id]].Version = version
+		setContributors(&result[positions[id]], contributors)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *postgresImpl) UpdateBook(ctx context.Context, update entity.BookUpdate) (entity.Book, error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return entity.Book{}, err
@@ -141,20 +425,10 @@ func (r *postgresImpl) UpdateBook(ctx context.Context, id string, name string, a
 
 	defer r.txRollback(ctx, tx)
 
-	book := entity.Book{
-		ID:        id,
-		Name:      name,
-		AuthorIDs: authorIDs,
-	}
+	const queryCurrentVersion = `SELECT version FROM book WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
 
-	const queryUpdateBook = `
-UPDATE book
-SET name=$2 
-WHERE id=$1
-RETURNING created_at, updated_at
-`
-
-	err = tx.QueryRow(ctx, queryUpdateBook, id, name).Scan(&book.CreatedAt, &book.UpdatedAt)
+	var version int64
+	err = tx.QueryRow(ctx, queryCurrentVersion, update.ID).Scan(&version)
 	if errors.Is(err, sql.ErrNoRows) {
 		return entity.Book{}, entity.ErrBookNotFound
 	}
@@ -162,29 +436,88 @@ RETURNING created_at, updated_at
 		r.logger.Error("Error while accessing to data base.", zap.Error(err))
 		return entity.Book{}, err
 	}
+	if update.ExpectedVersion != 0 && update.ExpectedVersion != version {
+		return entity.Book{}, entity.ErrVersionMismatch
+	}
+
+	args := []any{update.ID}
+	sets := make([]string, 0)
+
+	addSet := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
+	}
+
+	if update.Name != nil {
+		addSet("name", *update.Name)
+	}
+	if update.ISBN != nil {
+		addSet("isbn", nullIfZero(*update.ISBN))
+	}
+	if update.PublicationYear != nil {
+		addSet("publication_year", nullIfZero(*update.PublicationYear))
+	}
+	if update.Language != nil {
+		addSet("language", nullIfZero(*update.Language))
+	}
+	if update.PageCount != nil {
+		addSet("page_count", nullIfZero(*update.PageCount))
+	}
+	if update.Description != nil {
+		addSet("description", nullIfZero(*update.Description))
+	}
+	if update.Subtitle != nil {
+		addSet("subtitle", nullIfZero(*update.Subtitle))
+	}
+	if update.PublisherID != nil {
+		addSet("publisher_id", nullIfZero(*update.PublisherID))
+	}
+
+	// The row is touched anyway so that an authors only update bumps the version.
+	if len(sets) == 0 {
+		sets = append(sets, "name = name")
+	}
 
-	const queryDeleteBookAuthors = `DELETE FROM author_book WHERE book_id=ANY($1) AND author_id != ANY($2)`
-	_, err = tx.Exec(ctx, queryDeleteBookAuthors, []any{book.ID}, authorIDs)
+	_, err = tx.Exec(ctx, "UPDATE book SET "+strings.Join(sets, ", ")+" WHERE id = $1", args...)
 	if err != nil {
-		r.logger.Error("Error while accessing to data base.", zap.Error(err))
-		return entity.Book{}, err
+		return entity.Book{}, r.mapErr(err)
 	}
 
-	const queryAuthorBooks = `
-INSERT INTO author_book
-(author_id, book_id)
-VALUES ($1, $2)
-ON CONFLICT (book_id, author_id) DO NOTHING 
-`
+	if update.Contributors != nil || update.AuthorIDs != nil {
+		if err := r.updateWorkContributors(ctx, tx, update); err != nil {
+			return entity.Book{}, err
+		}
+	}
+
+	if update.GenreIDs != nil {
+		genreIDs := *update.GenreIDs
+		if genreIDs == nil {
+			genreIDs = []string{}
+		}
+
+		const queryDeleteBookGenres = `DELETE FROM book_genre WHERE book_id = $1 AND genre_id <> ALL($2)`
+		_, err = tx.Exec(ctx, queryDeleteBookGenres, update.ID, genreIDs)
+		if err != nil {
+			r.logger.Error("Error while accessing to data base.", zap.Error(err))
+			return entity.Book{}, err
+		}
 
-	for _, authorID := range book.AuthorIDs {
-		_, err = tx.Exec(ctx, queryAuthorBooks, authorID, book.ID)
+		const queryBookGenres = `
+INSERT INTO book_genre (book_id, genre_id)
+SELECT $1, unnest($2::uuid[])
+ON CONFLICT (book_id, genre_id) DO NOTHING`
 
+		_, err = tx.Exec(ctx, queryBookGenres, update.ID, genreIDs)
 		if err != nil {
 			return entity.Book{}, r.mapErr(err)
 		}
 	}
 
+	book, err := r.getBookFromRows(tx.QueryRow(ctx, queryBookInfo, update.ID))
+	if err != nil {
+		return entity.Book{}, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return entity.Book{}, err
 	}
@@ -192,16 +525,76 @@ ON CONFLICT (book_id, author_id) DO NOTHING
 	return book, nil
 }
 
-func (r *postgresImpl) GetBookInfo(ctx context.Context, id string) (entity.Book, error) {
+// updateWorkContributors replaces the contributors of the work of the book, so the change applies to every edition.
+func (r *postgresImpl) updateWorkContributors(ctx context.Context, tx pgx.Tx, update entity.BookUpdate) error {
+	// Authors set by ids keep their roles, only new ones become authors.
+	const queryWorkAuthors = `
+INSERT INTO author_work
+(author_id, work_id, role, position)
+SELECT $1, work_id, $3, $4 FROM book WHERE id = $2
+ON CONFLICT (author_id, work_id) DO UPDATE SET position = EXCLUDED.position
+`
+
+	const queryWorkContributors = `
+INSERT INTO author_work
+(author_id, work_id, role, position)
+SELECT $1, work_id, $3, $4 FROM book WHERE id = $2
+ON CONFLICT (author_id, work_id) DO UPDATE SET role = EXCLUDED.role, position = EXCLUDED.position
+`
+
+	query := queryWorkContributors
+	var contributors []entity.Contributor
+
+	if update.Contributors != nil {
+		contributors = *update.Contributors
+	} else {
+		query = queryWorkAuthors
+		contributors = workContributors(entity.Book{AuthorIDs: *update.AuthorIDs})
+	}
+
+	authorIDs := make([]string, len(contributors))
+	for i, contributor := range contributors {
+		authorIDs[i] = contributor.AuthorID
+	}
+
+	const queryDeleteWorkAuthors = `
+DELETE FROM author_work
+WHERE work_id = (SELECT work_id FROM book WHERE id = $1) AND author_id <> ALL($2)`
+	_, err := tx.Exec(ctx, queryDeleteWorkAuthors, update.ID, authorIDs)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+
+	for i, contributor := range contributors {
+		_, err = tx.Exec(ctx, query, contributor.AuthorID, update.ID, contributor.Role, i+1)
+
+		if err != nil {
+			return r.mapErr(err)
+		}
+	}
+
+	return r.touchWorkEditions(ctx, tx, update.ID)
+}
+
+// touchWorkEditions bumps the other editions of the work of the book after its authors change, so their etags change
+// and the change log gets an entry for each of them.
+func (r *postgresImpl) touchWorkEditions(ctx context.Context, tx pgx.Tx, bookID string) error {
 	const query = `
-		SELECT id, name, created_at, updated_at, array_agg(ab.author_id)
-		FROM book b
-		LEFT JOIN author_book ab on b.id = ab.book_id
-		WHERE b.id = $1
-		GROUP BY id, name, created_at, updated_at
-		`
+UPDATE book
+SET name = name
+WHERE work_id = (SELECT work_id FROM book WHERE id = $1) AND id <> $1 AND deleted_at IS NULL`
 
-	book, err := r.getBookFromRows(r.db.QueryRow(ctx, query, id))
+	if _, err := tx.Exec(ctx, query, bookID); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *postgresImpl) GetBookInfo(ctx context.Context, id string) (entity.Book, error) {
+	book, err := r.getBookFromRows(r.getQuerier(ctx).QueryRow(ctx, queryBookInfo, id))
 	if errors.Is(err, sql.ErrNoRows) {
 		return entity.Book{}, entity.ErrBookNotFound
 	}
@@ -213,6 +606,245 @@ func (r *postgresImpl) GetBookInfo(ctx context.Context, id string) (entity.Book,
 	return book, nil
 }
 
+func (r *postgresImpl) GetBookByISBN(ctx context.Context, isbn string) (entity.Book, error) {
+	const query = selectBooks + `WHERE b.isbn = $1 AND b.deleted_at IS NULL`
+
+	book, err := r.getBookFromRows(r.getQuerier(ctx).QueryRow(ctx, query, isbn))
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Book{}, entity.ErrBookNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, err
+	}
+
+	return book, nil
+}
+
+func (r *postgresImpl) GetBooksInfo(ctx context.Context, ids []string) ([]entity.Book, error) {
+	const queryBooks = selectBooks + `WHERE b.id = ANY($1) AND b.deleted_at IS NULL`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, queryBooks, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	books := make([]entity.Book, 0, len(ids))
+
+	for rows.Next() {
+		book, err := r.getBookFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+
+	return books, rows.Err()
+}
+
+// buildListBooksQuery builds the keyset paginated books query, a zero limit selects all matching books.
+func buildListBooksQuery(params entity.ListBooksParams) (string, []any) {
+	conditions := []string{"b.deleted_at IS NULL"}
+	args := make([]any, 0)
+
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	filter := params.Filter
+	if filter.NamePrefix != "" {
+		conditions = append(conditions, "b.name LIKE "+addArg(escapeLike(filter.NamePrefix)+"%"))
+	}
+	if filter.PublisherID != "" {
+		conditions = append(conditions, "b.publisher_id = "+addArg(filter.PublisherID))
+	}
+	if filter.GenreID != "" {
+		genres := addArg(filter.GenreID)
+		if filter.IncludeSubgenres {
+			genres = fmt.Sprintf(`(
+				WITH RECURSIVE subgenres AS (
+				    SELECT id FROM genre WHERE id = %s
+				    UNION ALL
+				    SELECT g.id FROM genre g JOIN subgenres s ON g.parent_id = s.id
+				)
+				SELECT id FROM subgenres)`, genres)
+		} else {
+			genres = "(" + genres + ")"
+		}
+
+		conditions = append(conditions,
+			"EXISTS (SELECT 1 FROM book_genre bg WHERE bg.book_id = b.id AND bg.genre_id IN "+genres+")")
+	}
+	if filter.AuthorID != "" {
+		contributor := "f.work_id = b.work_id AND f.author_id = " + resolvedAuthorID(addArg(filter.AuthorID))
+		if filter.AuthorRole != 0 {
+			contributor += " AND f.role = " + addArg(filter.AuthorRole)
+		}
+
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM author_work f WHERE "+contributor+")")
+	}
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, "b.created_at >= "+addArg(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, "b.created_at < "+addArg(*filter.CreatedBefore))
+	}
+	if filter.UpdatedAfter != nil {
+		conditions = append(conditions, "b.updated_at >= "+addArg(*filter.UpdatedAfter))
+	}
+	if filter.UpdatedBefore != nil {
+		conditions = append(conditions, "b.updated_at < "+addArg(*filter.UpdatedBefore))
+	}
+
+	sortColumn := "b.name"
+	if params.OrderBy == entity.BookOrderByCreatedAt {
+		sortColumn = "b.created_at"
+	}
+
+	direction, comparison := "ASC", ">"
+	if params.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if params.After != nil {
+		var key any = params.After.Name
+		if params.OrderBy == entity.BookOrderByCreatedAt {
+			key = params.After.CreatedAt
+		}
+
+		conditions = append(conditions,
+			fmt.Sprintf("(%s, b.id) %s (%s, %s)", sortColumn, comparison, addArg(key), addArg(params.After.ID)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT `+bookColumns+`, `+bookContributors+`
+		FROM book b
+		WHERE %s
+		ORDER BY %s %s, b.id %s
+		`, strings.Join(conditions, " AND "), sortColumn, direction, direction)
+
+	if params.Limit > 0 {
+		query += "LIMIT " + addArg(params.Limit)
+	}
+
+	return query, args
+}
+
+func (r *postgresImpl) ListBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error) {
+	query, args := buildListBooksQuery(params)
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	books := make([]entity.Book, 0, params.Limit)
+
+	for rows.Next() {
+		book, err := r.getBookFromRows(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+
+	return books, rows.Err()
+}
+
+// SearchCatalog parses the query with every search configuration, a book matches it in the configuration of its
+// language and an author in the simple one.
+func (r *postgresImpl) SearchCatalog(ctx context.Context, params entity.SearchParams) ([]entity.SearchResult, error) {
+	args := []any{params.Query, entity.SearchResultKindBook, entity.SearchResultKindAuthor}
+	bookConditions := []string{"b.deleted_at IS NULL", "b.search_vector @@ search.q"}
+	cursor := ""
+
+	if params.Language != "" {
+		args = append(args, params.Language)
+		bookConditions = append(bookConditions, fmt.Sprintf("lower(split_part(b.language, '-', 1)) = $%d", len(args)))
+	}
+
+	if params.After != nil {
+		args = append(args, params.After.Score, params.After.Kind, params.After.ID)
+		cursor = fmt.Sprintf("WHERE score < $%[1]d OR (score = $%[1]d AND (kind, id) > ($%[2]d, $%[3]d))",
+			len(args)-2, len(args)-1, len(args))
+	}
+
+	args = append(args, params.Limit)
+	query := fmt.Sprintf(`
+		WITH search AS (
+		    SELECT config, websearch_to_tsquery(config, $1) AS q
+		    FROM library_search_configs() AS config
+		)
+		SELECT kind, id, name, snippet, score
+		FROM (
+		    SELECT $2::int AS kind, b.id, b.name,
+		           ts_headline(search.config, b.name, search.q, 'StartSel=<b>, StopSel=</b>') AS snippet,
+		           ts_rank(b.search_vector, search.q) AS score
+		    FROM book b
+		    JOIN search ON search.config = library_language_config(b.language)
+		    WHERE %s
+		    UNION ALL
+		    SELECT $3::int AS kind, a.id, a.name,
+		           ts_headline(search.config, a.name, search.q, 'StartSel=<b>, StopSel=</b>') AS snippet,
+		           ts_rank(a.search_vector, search.q) AS score
+		    FROM author a
+		    JOIN search ON search.config = 'simple'::regconfig
+		    WHERE a.deleted_at IS NULL AND a.search_vector @@ search.q
+		) results
+		%s
+		ORDER BY score DESC, kind, id
+		LIMIT $%d
+		`, strings.Join(bookConditions, " AND "), cursor, len(args))
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := make([]entity.SearchResult, 0, params.Limit)
+
+	for rows.Next() {
+		var result entity.SearchResult
+		if err := rows.Scan(&result.Kind, &result.ID, &result.Name, &result.Snippet, &result.Score); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// authorColumns are scanned by authorFields.
+const authorColumns = `id, name, version, birth_date, death_date, COALESCE(nationality, ''), COALESCE(biography, ''),
+		aliases`
+
+func authorFields(author *entity.Author) []any {
+	return []any{
+		&author.ID, &author.Name, &author.Version, &author.BirthDate, &author.DeathDate, &author.Nationality,
+		&author.Biography, &author.Aliases,
+	}
+}
+
+// nullIfNoAliases stores missing aliases as an empty array, the column is not nullable.
+func nullIfNoAliases(aliases []string) []string {
+	if aliases == nil {
+		return []string{}
+	}
+
+	return aliases
+}
+
 func (r *postgresImpl) RegisterAuthor(ctx context.Context, author entity.Author) (resultAuthor entity.Author, txErr error) {
 	var (
 		tx  pgx.Tx
@@ -240,39 +872,91 @@ func (r *postgresImpl) RegisterAuthor(ctx context.Context, author entity.Author)
 		}()
 	}
 
-	const queryAuthor = `INSERT INTO author (name) VALUES ($1) RETURNING id`
-	err = tx.QueryRow(ctx, queryAuthor, author.Name).Scan(&author.ID)
+	const queryAuthor = `
+INSERT INTO author (name, birth_date, death_date, nationality, biography, aliases)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING ` + authorColumns
+	err = tx.QueryRow(ctx, queryAuthor, author.Name, author.BirthDate, author.DeathDate, nullIfZero(author.Nationality),
+		nullIfZero(author.Biography), nullIfNoAliases(author.Aliases)).Scan(authorFields(&author)...)
 	if err != nil {
-		r.logger.Error("Error while accessing to data base.", zap.Error(err))
-		return entity.Author{}, err
+		return entity.Author{}, r.mapErr(err)
 	}
 
 	return author, nil
 }
 
-func (r *postgresImpl) ChangeAuthorInfo(ctx context.Context, id string, name string) (entity.Author, error) {
-	const queryAuthor = `UPDATE author SET name = $2 WHERE id = $1`
-	result, err := r.db.Exec(ctx, queryAuthor, id, name)
-	if err != nil {
-		r.logger.Error("Error while accessing to data base.", zap.Error(err))
-		return entity.Author{}, err
+func (r *postgresImpl) ChangeAuthorInfo(ctx context.Context, update entity.AuthorUpdate) (entity.Author, error) {
+	args := []any{update.ID, update.ExpectedVersion}
+	sets := make([]string, 0)
+
+	addSet := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
 	}
-	if result.RowsAffected() == 0 {
-		return entity.Author{}, entity.ErrAuthorNotFound
+
+	if update.Name != nil {
+		addSet("name", *update.Name)
+	}
+	if update.BirthDate != nil {
+		addSet("birth_date", nullIfZero(*update.BirthDate))
+	}
+	if update.DeathDate != nil {
+		addSet("death_date", nullIfZero(*update.DeathDate))
+	}
+	if update.Nationality != nil {
+		addSet("nationality", nullIfZero(*update.Nationality))
+	}
+	if update.Biography != nil {
+		addSet("biography", nullIfZero(*update.Biography))
+	}
+	if update.Aliases != nil {
+		addSet("aliases", nullIfNoAliases(*update.Aliases))
 	}
 
-	author := entity.Author{
-		ID:   id,
-		Name: name,
+	// The row is touched anyway so that an empty update bumps the version as before.
+	if len(sets) == 0 {
+		sets = append(sets, "name = name")
 	}
 
-	return author, nil
+	queryAuthor := `
+UPDATE author
+SET ` + strings.Join(sets, ", ") + `
+WHERE id = $1 AND deleted_at IS NULL AND ($2::bigint = 0 OR version = $2::bigint)
+RETURNING ` + authorColumns
+
+	q := r.getQuerier(ctx)
+
+	var author entity.Author
+	err := q.QueryRow(ctx, queryAuthor, args...).Scan(authorFields(&author)...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entity.Author{}, r.mapErr(err)
+	}
+	if err == nil {
+		return author, nil
+	}
+
+	const queryExists = `SELECT EXISTS (SELECT 1 FROM author WHERE id = $1 AND deleted_at IS NULL)`
+
+	var exists bool
+	if err := q.QueryRow(ctx, queryExists, update.ID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Author{}, err
+	}
+	if exists {
+		return entity.Author{}, entity.ErrVersionMismatch
+	}
+
+	return entity.Author{}, entity.ErrAuthorNotFound
 }
 
+// GetAuthorInfo resolves the ids of merged authors to the author they were merged into.
 func (r *postgresImpl) GetAuthorInfo(ctx context.Context, id string) (entity.Author, error) {
-	const queryAuthor = `SELECT id, name FROM author WHERE id = ANY($1)`
+	const queryAuthor = `
+SELECT ` + authorColumns + `
+FROM author
+WHERE (id = ANY($1) OR id = (SELECT author_id FROM author_redirect WHERE id = ANY($1))) AND deleted_at IS NULL`
 	var author entity.Author
-	err := r.db.QueryRow(ctx, queryAuthor, []any{id}).Scan(&author.ID, &author.Name)
+	err := r.db.QueryRow(ctx, queryAuthor, []any{id}).Scan(authorFields(&author)...)
 	if errors.Is(err, sql.ErrNoRows) {
 		return entity.Author{}, entity.ErrAuthorNotFound
 	}
@@ -284,20 +968,95 @@ func (r *postgresImpl) GetAuthorInfo(ctx context.Context, id string) (entity.Aut
 	return author, nil
 }
 
-func (r *postgresImpl) GetAuthorBooks(ctx context.Context, id string) ([]entity.Book, error) {
-	const query = `
-		SELECT id, name, created_at, updated_at, array_agg(ab.author_id)
-		FROM book b
-		LEFT JOIN author_book ab on b.id = ab.book_id
-		WHERE b.id = ANY (
-		    SELECT ids.book_id 
-		    FROM author_book ids
-		    WHERE ids.author_id = $1
+// GetAuthorsInfo returns the authors by the requested ids, the ids of merged authors resolve to the author they were
+// merged into.
+func (r *postgresImpl) GetAuthorsInfo(ctx context.Context, ids []string) (map[string]entity.Author, error) {
+	const queryAuthors = `
+SELECT requested_id, ` + authorColumns + `
+FROM (
+    SELECT requested.id AS requested_id, COALESCE(redirect.author_id, requested.id) AS author_id
+    FROM unnest($1::uuid[]) AS requested (id)
+    LEFT JOIN author_redirect redirect ON redirect.id = requested.id
+) resolved
+JOIN author ON author.id = resolved.author_id
+WHERE deleted_at IS NULL`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, queryAuthors, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	authors := make(map[string]entity.Author, len(ids))
+
+	for rows.Next() {
+		var (
+			requestedID string
+			author      entity.Author
 		)
-		GROUP BY id, name, created_at, updated_at
-		`
+		if err := rows.Scan(append([]any{&requestedID}, authorFields(&author)...)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		authors[requestedID] = author
+	}
+
+	return authors, rows.Err()
+}
+
+func (r *postgresImpl) ListAuthors(ctx context.Context, params entity.ListAuthorsParams) ([]entity.Author, error) {
+	conditions := []string{"deleted_at IS NULL"}
+	args := make([]any, 0)
+
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	filter := params.Filter
+	if filter.Name != "" {
+		column, alias, value := "name", "alias", filter.Name
+		if filter.CaseInsensitive {
+			column, alias, value = "lower(name)", "lower(alias)", strings.ToLower(filter.Name)
+		}
+
+		match := " LIKE " + addArg(escapeLike(value)+"%")
+		if filter.NameMatch == entity.AuthorNameMatchExact {
+			match = " = " + addArg(value)
+		}
+
+		// Authors are also found by any of their aliases. An OR of the name and alias matches would be a sequential
+		// scan, so each of them is looked up by its own index: the name by index_author_name or
+		// index_author_lower_name, exact aliases by index_author_aliases and the rest by index_author_aliases_trgm,
+		// which only narrows the rows down for the unnest check.
+		aliasIndexMatch := "author_aliases_text(aliases) LIKE " + addArg("%"+escapeLike(strings.ToLower(filter.Name))+"%")
+		if filter.NameMatch == entity.AuthorNameMatchExact && !filter.CaseInsensitive {
+			aliasIndexMatch = "aliases @> ARRAY[" + addArg(value) + "]::text[]"
+		}
+
+		conditions = append(conditions, fmt.Sprintf(`id IN (
+			SELECT id FROM author WHERE %s%s
+			UNION
+			SELECT id FROM author WHERE %s AND EXISTS (SELECT 1 FROM unnest(aliases) alias WHERE %s%s)
+		)`, column, match, aliasIndexMatch, alias, match))
+	}
+
+	if params.After != nil {
+		conditions = append(conditions,
+			fmt.Sprintf("(name, id) > (%s, %s)", addArg(params.After.Name), addArg(params.After.ID)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT `+authorColumns+`
+		FROM author
+		WHERE %s
+		ORDER BY name, id
+		LIMIT %s
+		`, strings.Join(conditions, " AND "), addArg(params.Limit))
 
-	rows, err := r.db.Query(ctx, query, id)
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
 	if err != nil {
 		r.logger.Error("Error while accessing to data base.", zap.Error(err))
 		return nil, err
@@ -305,15 +1064,214 @@ func (r *postgresImpl) GetAuthorBooks(ctx context.Context, id string) ([]entity.
 
 	defer rows.Close()
 
-	authorBooks := make([]entity.Book, 0)
+	authors := make([]entity.Author, 0, params.Limit)
 
 	for rows.Next() {
-		book, err := r.getBookFromRows(rows)
-		if err != nil {
+		var author entity.Author
+		if err := rows.Scan(authorFields(&author)...); err != nil {
 			r.logger.Error("Error while working with row.", zap.Error(err))
 			return nil, err
 		}
-		authorBooks = append(authorBooks, book)
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
+
+func (r *postgresImpl) GetAuthorBooks(ctx context.Context, params entity.ListBooksParams, handle func(entity.Book) error) error {
+	queryAuthorExists := `SELECT EXISTS (SELECT 1 FROM author WHERE id = ` + resolvedAuthorID("$1") + ` AND deleted_at IS NULL)`
+
+	q := r.getQuerier(ctx)
+
+	var exists bool
+	if err := q.QueryRow(ctx, queryAuthorExists, params.Filter.AuthorID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+	if !exists {
+		return entity.ErrAuthorNotFound
+	}
+
+	query, args := buildListBooksQuery(params)
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		book, err := r.getBookFromRows(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := handle(book); err != nil {
+			return err
+		}
 	}
-	return authorBooks, nil
+
+	return rows.Err()
+}
+
+// DeleteBook is expected to run in a transaction. The copies of the book are locked before its circulation is checked,
+// so a concurrent checkout either commits first and is seen here or finds the book deleted.
+func (r *postgresImpl) DeleteBook(ctx context.Context, id string) (entity.Book, error) {
+	const (
+		queryLock        = `SELECT id FROM book WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+		queryLockCopies  = `SELECT id FROM copy WHERE book_id = $1 ORDER BY id FOR UPDATE`
+		queryCirculation = `
+SELECT EXISTS (SELECT 1 FROM loan l JOIN copy c ON c.id = l.copy_id WHERE c.book_id = $1 AND l.returned_at IS NULL)
+    OR EXISTS (SELECT 1 FROM hold WHERE book_id = $1 AND status IN ($2, $3, $4))
+    OR EXISTS (SELECT 1 FROM transfer t JOIN copy c ON c.id = t.copy_id WHERE c.book_id = $1 AND t.status IN ($5, $6))`
+		queryDelete = `
+UPDATE book b
+SET deleted_at = now()
+WHERE b.id = $1
+RETURNING ` + bookColumns + `, b.deleted_at
+`
+	)
+
+	q := r.getQuerier(ctx)
+
+	var bookID string
+	err := q.QueryRow(ctx, queryLock, id).Scan(&bookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Book{}, entity.ErrBookNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, err
+	}
+
+	if _, err := q.Exec(ctx, queryLockCopies, id); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, err
+	}
+
+	var inCirculation bool
+	err = q.QueryRow(ctx, queryCirculation, id, entity.HoldStatusWaiting, entity.HoldStatusReady,
+		entity.HoldStatusInTransit, entity.TransferStatusRequested, entity.TransferStatusInTransit).Scan(&inCirculation)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, err
+	}
+	if inCirculation {
+		return entity.Book{}, entity.ErrBookInCirculation
+	}
+
+	var book entity.Book
+	err = q.QueryRow(ctx, queryDelete, id).Scan(append(bookFields(&book), &book.DeletedAt)...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Book{}, err
+	}
+
+	return book, nil
+}
+
+func (r *postgresImpl) RestoreBook(ctx context.Context, id string) (entity.Book, error) {
+	const query = `UPDATE book SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	q := r.getQuerier(ctx)
+	result, err := q.Exec(ctx, query, id)
+	if err != nil {
+		return entity.Book{}, r.mapErr(err)
+	}
+	if result.RowsAffected() == 0 {
+		return entity.Book{}, entity.ErrBookNotFound
+	}
+
+	book, err := r.getBookFromRows(q.QueryRow(ctx, queryBookInfo, id))
+	if err != nil {
+		return entity.Book{}, err
+	}
+
+	return book, nil
+}
+
+// PurgeBooks also removes works left without editions. Books with copies or holds are kept, so that loans, fines
+// and holds are never removed with them.
+func (r *postgresImpl) PurgeBooks(ctx context.Context, retention time.Duration) (int64, error) {
+	const query = `
+WITH purged AS (
+    DELETE FROM book b
+    WHERE b.deleted_at < now() - $1::interval
+      AND NOT EXISTS (SELECT 1 FROM copy c WHERE c.book_id = b.id)
+      AND NOT EXISTS (SELECT 1 FROM hold h WHERE h.book_id = b.id)
+    RETURNING id, work_id
+), orphaned AS (
+    DELETE FROM work w
+    WHERE w.id IN (SELECT work_id FROM purged)
+      AND NOT EXISTS (SELECT 1 FROM book b WHERE b.work_id = w.id AND b.id NOT IN (SELECT id FROM purged))
+)
+SELECT count(*) FROM purged`
+
+	interval := fmt.Sprintf("%d ms", retention.Milliseconds())
+
+	var purged int64
+	if err := r.getQuerier(ctx).QueryRow(ctx, query, interval).Scan(&purged); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+func (r *postgresImpl) DeleteAuthor(ctx context.Context, id string) (entity.Author, error) {
+	const query = `
+UPDATE author
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, version, deleted_at
+`
+
+	var author entity.Author
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(&author.ID, &author.Name, &author.Version, &author.DeletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Author{}, entity.ErrAuthorNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Author{}, err
+	}
+
+	return author, nil
+}
+
+// RestoreAuthor does not restore the authors merged into another one.
+func (r *postgresImpl) RestoreAuthor(ctx context.Context, id string) (entity.Author, error) {
+	const query = `
+UPDATE author
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL AND NOT EXISTS (SELECT 1 FROM author_redirect WHERE author_redirect.id = $1)
+RETURNING ` + authorColumns
+
+	var author entity.Author
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(authorFields(&author)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Author{}, entity.ErrAuthorNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Author{}, err
+	}
+
+	return author, nil
+}
+
+func (r *postgresImpl) PurgeAuthors(ctx context.Context, retention time.Duration) (int64, error) {
+	const query = `DELETE FROM author WHERE deleted_at < now() - $1::interval`
+
+	interval := fmt.Sprintf("%d ms", retention.Milliseconds())
+
+	result, err := r.getQuerier(ctx).Exec(ctx, query, interval)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
 }
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ PublisherRepository = (*postgresImpl)(nil)
+
+const publisherColumns = `id, name, COALESCE(country, ''), COALESCE(website, ''), created_at, updated_at, version`
+
+func publisherFields(publisher *entity.Publisher) []any {
+	return []any{
+		&publisher.ID, &publisher.Name, &publisher.Country, &publisher.Website,
+		&publisher.CreatedAt, &publisher.UpdatedAt, &publisher.Version,
+	}
+}
+
+func (r *postgresImpl) RegisterPublisher(ctx context.Context, publisher entity.Publisher) (entity.Publisher, error) {
+	const query = `
+INSERT INTO publisher (name, country, website)
+VALUES ($1, $2, $3)
+RETURNING ` + publisherColumns
+
+	var result entity.Publisher
+	err := r.getQuerier(ctx).
+		QueryRow(ctx, query, publisher.Name, nullIfZero(publisher.Country), nullIfZero(publisher.Website)).
+		Scan(publisherFields(&result)...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Publisher{}, err
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) GetPublisherInfo(ctx context.Context, id string) (entity.Publisher, error) {
+	const query = `SELECT ` + publisherColumns + ` FROM publisher WHERE id = $1`
+
+	var publisher entity.Publisher
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(publisherFields(&publisher)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Publisher{}, entity.ErrPublisherNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Publisher{}, err
+	}
+
+	return publisher, nil
+}
+
+func (r *postgresImpl) GetPublishersInfo(ctx context.Context, ids []string) ([]entity.Publisher, error) {
+	const query = `SELECT ` + publisherColumns + ` FROM publisher WHERE id = ANY($1)`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	publishers := make([]entity.Publisher, 0, len(ids))
+
+	for rows.Next() {
+		var publisher entity.Publisher
+		if err := rows.Scan(publisherFields(&publisher)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		publishers = append(publishers, publisher)
+	}
+
+	return publishers, rows.Err()
+}
+
+func (r *postgresImpl) ListPublisherBooks(ctx context.Context, params entity.ListBooksParams) ([]entity.Book, error) {
+	const queryPublisherExists = `SELECT EXISTS (SELECT 1 FROM publisher WHERE id = $1)`
+
+	q := r.getQuerier(ctx)
+
+	var exists bool
+	if err := q.QueryRow(ctx, queryPublisherExists, params.Filter.PublisherID).Scan(&exists); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.ErrPublisherNotFound
+	}
+
+	return r.ListBooks(ctx, params)
+}
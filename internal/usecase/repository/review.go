@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ ReviewRepository = (*postgresImpl)(nil)
+
+const reviewColumns = `id, book_id, patron_id, rating, COALESCE(text, ''), status, COALESCE(moderation_note, ''),
+		created_at, updated_at`
+
+func reviewFields(review *entity.Review) []any {
+	return []any{
+		&review.ID, &review.BookID, &review.PatronID, &review.Rating, &review.Text, &review.Status,
+		&review.ModerationNote, &review.CreatedAt, &review.UpdatedAt,
+	}
+}
+
+// CreateReview adds a pending review of a book that is not deleted.
+func (r *postgresImpl) CreateReview(ctx context.Context, review entity.Review) (entity.Review, error) {
+	const query = `
+INSERT INTO review (book_id, patron_id, rating, text)
+SELECT id, $2, $3, $4 FROM book WHERE id = $1 AND deleted_at IS NULL
+RETURNING ` + reviewColumns
+
+	var result entity.Review
+	err := r.getQuerier(ctx).QueryRow(ctx, query, review.BookID, review.PatronID, review.Rating,
+		nullIfZero(review.Text)).Scan(reviewFields(&result)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Review{}, entity.ErrBookNotFound
+	}
+	if err != nil {
+		return entity.Review{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+// LockReview is expected to run in a transaction.
+func (r *postgresImpl) LockReview(ctx context.Context, id string) (entity.Review, error) {
+	const query = `SELECT ` + reviewColumns + ` FROM review WHERE id = $1 FOR UPDATE`
+
+	var review entity.Review
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(reviewFields(&review)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Review{}, entity.ErrReviewNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Review{}, err
+	}
+
+	return review, nil
+}
+
+func (r *postgresImpl) ModerateReview(ctx context.Context, id string, status entity.ReviewStatus, note string) (entity.Review, error) {
+	const query = `UPDATE review SET status = $2, moderation_note = $3 WHERE id = $1 RETURNING ` + reviewColumns
+
+	var review entity.Review
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id, status, nullIfZero(note)).Scan(reviewFields(&review)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Review{}, entity.ErrReviewNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Review{}, err
+	}
+
+	return review, nil
+}
+
+func (r *postgresImpl) ListReviews(ctx context.Context, params entity.ListReviewsParams) ([]entity.Review, error) {
+	args := []any{params.BookID, params.Status}
+	conditions := []string{"book_id = $1", "status = $2"}
+
+	if params.After != nil {
+		args = append(args, params.After.CreatedAt, params.After.ID)
+		conditions = append(conditions, "(created_at, id) < ($3, $4)")
+	}
+
+	args = append(args, params.Limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM review
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+		`, reviewColumns, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	reviews := make([]entity.Review, 0, params.Limit)
+
+	for rows.Next() {
+		var review entity.Review
+		if err := rows.Scan(reviewFields(&review)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+// GetBookRating returns the zero rating for books without approved reviews.
+func (r *postgresImpl) GetBookRating(ctx context.Context, bookID string) (entity.BookRating, error) {
+	const query = `SELECT rating_sum, review_count FROM book_rating WHERE book_id = $1`
+
+	rating := entity.BookRating{BookID: bookID}
+	err := r.getQuerier(ctx).QueryRow(ctx, query, bookID).Scan(&rating.RatingSum, &rating.ReviewCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rating, nil
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.BookRating{}, err
+	}
+
+	return rating, nil
+}
+
+// AdjustBookRating adds the deltas to the aggregates of the book.
+func (r *postgresImpl) AdjustBookRating(ctx context.Context, bookID string, ratingDelta int64, countDelta int) error {
+	const query = `
+INSERT INTO book_rating (book_id, rating_sum, review_count)
+VALUES ($1, $2, $3)
+ON CONFLICT (book_id) DO UPDATE
+    SET rating_sum   = book_rating.rating_sum + EXCLUDED.rating_sum,
+        review_count = book_rating.review_count + EXCLUDED.review_count`
+
+	if _, err := r.getQuerier(ctx).Exec(ctx, query, bookID, ratingDelta, countDelta); err != nil {
+		return r.mapErr(err)
+	}
+
+	return nil
+}
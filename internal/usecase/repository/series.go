@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ SeriesRepository = (*postgresImpl)(nil)
+
+const seriesColumns = `id, name, COALESCE(description, ''), created_at, updated_at`
+
+func seriesFields(series *entity.Series) []any {
+	return []any{&series.ID, &series.Name, &series.Description, &series.CreatedAt, &series.UpdatedAt}
+}
+
+func (r *postgresImpl) CreateSeries(ctx context.Context, series entity.Series) (entity.Series, error) {
+	const query = `INSERT INTO series (name, description) VALUES ($1, $2) RETURNING ` + seriesColumns
+
+	var result entity.Series
+	err := r.getQuerier(ctx).QueryRow(ctx, query, series.Name, nullIfZero(series.Description)).Scan(seriesFields(&result)...)
+	if err != nil {
+		return entity.Series{}, r.mapErr(err)
+	}
+
+	return result, nil
+}
+
+func (r *postgresImpl) GetSeries(ctx context.Context, id string) (entity.Series, error) {
+	const query = `SELECT ` + seriesColumns + ` FROM series WHERE id = $1`
+
+	const queryVolumes = `
+SELECT sb.series_id, sb.book_id, sb.volume
+FROM series_book sb
+JOIN book b ON b.id = sb.book_id
+WHERE sb.series_id = $1 AND b.deleted_at IS NULL
+ORDER BY sb.volume`
+
+	q := r.getQuerier(ctx)
+
+	var series entity.Series
+	err := q.QueryRow(ctx, query, id).Scan(seriesFields(&series)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Series{}, entity.ErrSeriesNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Series{}, err
+	}
+
+	rows, err := q.Query(ctx, queryVolumes, id)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Series{}, err
+	}
+
+	defer rows.Close()
+
+	series.Volumes = make([]entity.SeriesVolume, 0)
+
+	for rows.Next() {
+		var volume entity.SeriesVolume
+		if err := rows.Scan(&volume.SeriesID, &volume.BookID, &volume.Volume); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return entity.Series{}, err
+		}
+		series.Volumes = append(series.Volumes, volume)
+	}
+
+	return series, rows.Err()
+}
+
+// LockSeries locks the series rows until the end of the transaction, ids are locked in a stable order.
+func (r *postgresImpl) LockSeries(ctx context.Context, ids []string) error {
+	const query = `SELECT count(*) FROM (SELECT id FROM series WHERE id = ANY($1) ORDER BY id FOR UPDATE) s`
+
+	var locked int
+	if err := r.getQuerier(ctx).QueryRow(ctx, query, ids).Scan(&locked); err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+	if locked != len(ids) {
+		return entity.ErrSeriesNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresImpl) GetBookSeries(ctx context.Context, bookID string) (entity.SeriesVolume, error) {
+	const query = `
+SELECT sb.series_id, s.name, sb.book_id, sb.volume
+FROM series_book sb
+JOIN series s ON s.id = sb.series_id
+WHERE sb.book_id = $1`
+
+	var volume entity.SeriesVolume
+	err := r.getQuerier(ctx).QueryRow(ctx, query, bookID).
+		Scan(&volume.SeriesID, &volume.SeriesName, &volume.BookID, &volume.Volume)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.SeriesVolume{}, entity.ErrBookNotInSeries
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.SeriesVolume{}, err
+	}
+
+	return volume, nil
+}
+
+// SetBookSeries adds the book to the series or moves it there from its current series.
+func (r *postgresImpl) SetBookSeries(ctx context.Context, volume entity.SeriesVolume) error {
+	const query = `
+INSERT INTO series_book (book_id, series_id, volume)
+VALUES ($1, $2, $3)
+ON CONFLICT (book_id) DO UPDATE SET series_id = excluded.series_id, volume = excluded.volume`
+
+	q := r.getQuerier(ctx)
+
+	if _, err := q.Exec(ctx, query, volume.BookID, volume.SeriesID, volume.Volume); err != nil {
+		return r.mapErr(err)
+	}
+
+	return r.checkSeriesVolumes(ctx, q)
+}
+
+// SetSeriesVolumes renumbers books already in the series, volumes may be swapped between them.
+func (r *postgresImpl) SetSeriesVolumes(ctx context.Context, seriesID string, volumes []entity.SeriesVolume) error {
+	const query = `UPDATE series_book SET volume = $3 WHERE book_id = $1 AND series_id = $2`
+
+	q := r.getQuerier(ctx)
+
+	for _, volume := range volumes {
+		result, err := q.Exec(ctx, query, volume.BookID, seriesID, volume.Volume)
+		if err != nil {
+			return r.mapErr(err)
+		}
+		if result.RowsAffected() == 0 {
+			return entity.ErrBookNotInSeries
+		}
+	}
+
+	return r.checkSeriesVolumes(ctx, q)
+}
+
+// checkSeriesVolumes runs the deferred volume uniqueness check now, so that it is not reported by the commit.
+func (r *postgresImpl) checkSeriesVolumes(ctx context.Context, q querier) error {
+	const query = `SET CONSTRAINTS series_book_volume_key IMMEDIATE`
+
+	if _, err := q.Exec(ctx, query); err != nil {
+		return r.mapErr(err)
+	}
+
+	return nil
+}
+
+func (r *postgresImpl) RemoveBookFromSeries(ctx context.Context, bookID string) error {
+	const query = `DELETE FROM series_book WHERE book_id = $1`
+
+	result, err := r.getQuerier(ctx).Exec(ctx, query, bookID)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return entity.ErrBookNotInSeries
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ TransferRepository = (*postgresImpl)(nil)
+
+const transferColumns = `t.id, t.copy_id, t.from_branch_id, t.to_branch_id, t.status, t.shipped_at, t.received_at,
+		t.created_at, t.updated_at`
+
+func transferFields(transfer *entity.Transfer) []any {
+	return []any{
+		&transfer.ID, &transfer.CopyID, &transfer.FromBranchID, &transfer.ToBranchID, &transfer.Status,
+		&transfer.ShippedAt, &transfer.ReceivedAt, &transfer.CreatedAt, &transfer.UpdatedAt,
+	}
+}
+
+// CreateTransfer requests moving the copy from the branch it is currently at.
+func (r *postgresImpl) CreateTransfer(ctx context.Context, copyID string, toBranchID string) (entity.Transfer, error) {
+	const query = `
+WITH t AS (
+    INSERT INTO transfer (copy_id, from_branch_id, to_branch_id)
+    SELECT id, current_branch_id, $2 FROM copy WHERE id = $1
+    RETURNING *
+)
+SELECT ` + transferColumns + ` FROM t`
+
+	var transfer entity.Transfer
+	err := r.getQuerier(ctx).QueryRow(ctx, query, copyID, toBranchID).Scan(transferFields(&transfer)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Transfer{}, entity.ErrCopyNotFound
+	}
+	if err != nil {
+		return entity.Transfer{}, r.mapErr(err)
+	}
+
+	return transfer, nil
+}
+
+// LockTransfer is expected to run in a transaction.
+func (r *postgresImpl) LockTransfer(ctx context.Context, id string) (entity.Transfer, error) {
+	const query = `SELECT ` + transferColumns + ` FROM transfer t WHERE t.id = $1 FOR UPDATE`
+
+	var transfer entity.Transfer
+	err := r.getQuerier(ctx).QueryRow(ctx, query, id).Scan(transferFields(&transfer)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Transfer{}, entity.ErrTransferNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// ShipTransfer sends the copy on its way, it stays in transit until it is received.
+func (r *postgresImpl) ShipTransfer(ctx context.Context, id string) (entity.Transfer, error) {
+	const query = `
+WITH t AS (
+    UPDATE transfer SET status = $2, shipped_at = now() WHERE id = $1 RETURNING *
+), c AS (
+    UPDATE copy SET status = $3 FROM t WHERE copy.id = t.copy_id RETURNING copy.id
+)
+SELECT ` + transferColumns + ` FROM t`
+
+	return r.updateTransfer(ctx, query, id, entity.TransferStatusInTransit, entity.CopyStatusInTransit)
+}
+
+// ReceiveTransfer moves the copy to the destination branch and makes it available there.
+func (r *postgresImpl) ReceiveTransfer(ctx context.Context, id string) (entity.Transfer, error) {
+	const query = `
+WITH t AS (
+    UPDATE transfer SET status = $2, received_at = now() WHERE id = $1 RETURNING *
+), c AS (
+    UPDATE copy SET status = $3, current_branch_id = t.to_branch_id FROM t WHERE copy.id = t.copy_id RETURNING copy.id
+)
+SELECT ` + transferColumns + ` FROM t`
+
+	return r.updateTransfer(ctx, query, id, entity.TransferStatusReceived, entity.CopyStatusAvailable)
+}
+
+func (r *postgresImpl) updateTransfer(ctx context.Context, query string, args ...any) (entity.Transfer, error) {
+	var transfer entity.Transfer
+	err := r.getQuerier(ctx).QueryRow(ctx, query, args...).Scan(transferFields(&transfer)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Transfer{}, entity.ErrTransferNotFound
+	}
+	if err != nil {
+		return entity.Transfer{}, r.mapErr(err)
+	}
+
+	return transfer, nil
+}
+
+// CancelPendingTransfer cancels the transfer of the copy that has not been shipped yet and reports whether there was one.
+func (r *postgresImpl) CancelPendingTransfer(ctx context.Context, copyID string) (bool, error) {
+	const query = `UPDATE transfer SET status = $2 WHERE copy_id = $1 AND status = $3`
+
+	result, err := r.getQuerier(ctx).Exec(ctx, query, copyID, entity.TransferStatusCancelled,
+		entity.TransferStatusRequested)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// ListTransfers returns active transfers, the oldest first.
+func (r *postgresImpl) ListTransfers(ctx context.Context, filter entity.TransferFilter) ([]entity.Transfer, error) {
+	args := []any{entity.TransferStatusRequested, entity.TransferStatusInTransit}
+	conditions := []string{"t.status IN ($1, $2)"}
+
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.BranchID != "" {
+		branchID := addArg(filter.BranchID)
+		conditions = append(conditions, "(t.from_branch_id = "+branchID+" OR t.to_branch_id = "+branchID+")")
+	}
+	if filter.Status != 0 {
+		conditions = append(conditions, "t.status = "+addArg(filter.Status))
+	}
+
+	query := `SELECT ` + transferColumns + ` FROM transfer t WHERE ` + strings.Join(conditions, " AND ") +
+		` ORDER BY t.created_at, t.id`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transfers := make([]entity.Transfer, 0)
+
+	for rows.Next() {
+		var transfer entity.Transfer
+		if err := rows.Scan(transferFields(&transfer)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, rows.Err()
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var _ WorkRepository = (*postgresImpl)(nil)
+
+const workColumns = `w.id, w.name, ARRAY(
+    SELECT aw.author_id::text
+    FROM author_work aw
+    JOIN author a on a.id = aw.author_id
+    WHERE aw.work_id = w.id AND a.deleted_at IS NULL
+    ORDER BY aw.position, aw.author_id
+), w.created_at, w.updated_at`
+
+func workFields(work *entity.Work) []any {
+	return []any{&work.ID, &work.Name, &work.AuthorIDs, &work.CreatedAt, &work.UpdatedAt}
+}
+
+func (r *postgresImpl) addWork(ctx context.Context, tx pgx.Tx, name string, contributors []entity.Contributor) (string, error) {
+	const query = `INSERT INTO work (name) VALUES ($1) RETURNING id`
+
+	var id string
+	if err := tx.QueryRow(ctx, query, name).Scan(&id); err != nil {
+		return "", r.mapErr(err)
+	}
+
+	if err := r.addWorkAuthors(ctx, tx, id, contributors); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *postgresImpl) getWorkContributors(ctx context.Context, tx pgx.Tx, workID string) ([]contributorRow, error) {
+	const query = selectContributors + `$1`
+
+	var contributors []contributorRow
+	err := tx.QueryRow(ctx, query, workID).Scan(&contributors)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	return contributors, nil
+}
+
+func (r *postgresImpl) GetWork(ctx context.Context, id string) (entity.Work, error) {
+	const query = `SELECT ` + workColumns + ` FROM work w WHERE w.id = $1`
+
+	const queryEditions = selectBooks + `
+		WHERE b.work_id = $1 AND b.deleted_at IS NULL
+		ORDER BY b.publication_year NULLS LAST, b.created_at, b.id`
+
+	q := r.getQuerier(ctx)
+
+	var work entity.Work
+	err := q.QueryRow(ctx, query, id).Scan(workFields(&work)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Work{}, entity.ErrWorkNotFound
+	}
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Work{}, err
+	}
+
+	rows, err := q.Query(ctx, queryEditions, id)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return entity.Work{}, err
+	}
+
+	defer rows.Close()
+
+	work.Editions = make([]entity.Book, 0)
+
+	for rows.Next() {
+		book, err := r.getBookFromRows(rows)
+		if err != nil {
+			return entity.Work{}, err
+		}
+		work.Editions = append(work.Editions, book)
+	}
+
+	return work, rows.Err()
+}
+
+func (r *postgresImpl) GetWorksInfo(ctx context.Context, ids []string) ([]entity.Work, error) {
+	const query = `SELECT ` + workColumns + ` FROM work w WHERE w.id = ANY($1)`
+
+	rows, err := r.getQuerier(ctx).Query(ctx, query, ids)
+	if err != nil {
+		r.logger.Error("Error while accessing to data base.", zap.Error(err))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	works := make([]entity.Work, 0, len(ids))
+
+	for rows.Next() {
+		var work entity.Work
+		if err := rows.Scan(workFields(&work)...); err != nil {
+			r.logger.Error("Error while working with row.", zap.Error(err))
+			return nil, err
+		}
+		works = append(works, work)
+	}
+
+	return works, rows.Err()
+}